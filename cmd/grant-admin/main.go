@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/s0vunia/password-manager/internal/config"
+	"github.com/s0vunia/password-manager/internal/repositories/user"
+	log "github.com/sirupsen/logrus"
+	"os/signal"
+	"syscall"
+)
+
+// grant-admin lets the user with the login call admin operations, or takes that away with -revoke.
+// Admins are never created by migrations, the first one has to be granted with this command.
+func main() {
+	var login string
+	var revoke bool
+	flag.StringVar(&login, "login", "", "login of the user")
+	flag.BoolVar(&revoke, "revoke", false, "revoke admin rights instead of granting them")
+
+	cfg := config.MustLoad()
+	if login == "" {
+		log.Fatal("Login is empty, pass it with -login")
+	}
+	dataSourceName := fmt.Sprintf("host=%s port=%s dbname=%s user=%s password=%s sslmode=disable",
+		cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.DbName, cfg.Postgres.User, cfg.Postgres.Password)
+	userRepository, err := user.NewPostgresRepository(dataSourceName)
+	if err != nil {
+		log.Fatalf("Failed to init user repo: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	if err := userRepository.SetAdmin(ctx, login, !revoke); err != nil {
+		log.Fatalf("Failed to update admin rights of %s: %v", login, err)
+	}
+	if revoke {
+		log.Infof("Revoked admin rights of %s", login)
+		return
+	}
+	log.Infof("Granted admin rights to %s", login)
+}
@@ -4,15 +4,45 @@ import (
 	"fmt"
 	"github.com/s0vunia/password-manager/internal/app"
 	"github.com/s0vunia/password-manager/internal/config"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/hibp"
+	"github.com/s0vunia/password-manager/internal/lib/jwt"
+	"github.com/s0vunia/password-manager/internal/lib/webauthn"
+	apiTokenRepo "github.com/s0vunia/password-manager/internal/repositories/apiToken"
 	appRepo "github.com/s0vunia/password-manager/internal/repositories/app"
+	folderRepo "github.com/s0vunia/password-manager/internal/repositories/folder"
 	itemRepo "github.com/s0vunia/password-manager/internal/repositories/item"
+	apiKeyItemRepo "github.com/s0vunia/password-manager/internal/repositories/item/apiKeyItem"
+	cardItemRepo "github.com/s0vunia/password-manager/internal/repositories/item/cardItem"
+	identityItemRepo "github.com/s0vunia/password-manager/internal/repositories/item/identityItem"
 	loginItemRepo "github.com/s0vunia/password-manager/internal/repositories/item/loginItem"
+	noteItemRepo "github.com/s0vunia/password-manager/internal/repositories/item/noteItem"
+	sshKeyItemRepo "github.com/s0vunia/password-manager/internal/repositories/item/sshKeyItem"
+	oauthRepo "github.com/s0vunia/password-manager/internal/repositories/oauth"
+	revocationRepo "github.com/s0vunia/password-manager/internal/repositories/revocation"
+	sessionRepo "github.com/s0vunia/password-manager/internal/repositories/session"
+	signingKeyRepo "github.com/s0vunia/password-manager/internal/repositories/signingKey"
 	"github.com/s0vunia/password-manager/internal/repositories/user"
+	apiTokenService "github.com/s0vunia/password-manager/internal/services/apiToken"
+	appService "github.com/s0vunia/password-manager/internal/services/app"
 	"github.com/s0vunia/password-manager/internal/services/auth"
+	"github.com/s0vunia/password-manager/internal/services/keys"
+	"github.com/s0vunia/password-manager/internal/services/manager/apiKeyItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/breach"
+	"github.com/s0vunia/password-manager/internal/services/manager/cardItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/folder"
+	"github.com/s0vunia/password-manager/internal/services/manager/generator"
+	"github.com/s0vunia/password-manager/internal/services/manager/health"
+	"github.com/s0vunia/password-manager/internal/services/manager/identityItem"
 	"github.com/s0vunia/password-manager/internal/services/manager/item"
 	"github.com/s0vunia/password-manager/internal/services/manager/loginItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/noteItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/sshKeyItem"
+	"github.com/s0vunia/password-manager/internal/services/revocation"
+	"github.com/s0vunia/password-manager/internal/services/signing"
 	log "github.com/sirupsen/logrus"
 	"log/slog"
+	"net/url"
 	"os"
 	"os/signal"
 	"syscall"
@@ -46,28 +76,152 @@ func Start() {
 	if err != nil {
 		log.Fatalf("Failed to init app repo: %v", err)
 	}
+	sessionRepository, err := sessionRepo.NewPostgresRepository(dataSourceName)
+	if err != nil {
+		log.Fatalf("Failed to init session repo: %v", err)
+	}
+	revocationRepository, err := revocationRepo.NewPostgresRepository(dataSourceName)
+	if err != nil {
+		log.Fatalf("Failed to init revocation repo: %v", err)
+	}
+	signingKeyRepository, err := signingKeyRepo.NewPostgresRepository(dataSourceName)
+	if err != nil {
+		log.Fatalf("Failed to init signing key repo: %v", err)
+	}
+	oauthRepository, err := oauthRepo.NewPostgresRepository(dataSourceName)
+	if err != nil {
+		log.Fatalf("Failed to init oauth repo: %v", err)
+	}
+	apiTokenRepository, err := apiTokenRepo.NewPostgresRepository(dataSourceName)
+	if err != nil {
+		log.Fatalf("Failed to init api token repo: %v", err)
+	}
 	itemRepository, err := itemRepo.NewPostgresRepository(dataSourceName)
 	if err != nil {
 		log.Fatalf("Failed to init item repo: %v", err)
 	}
+	folderRepository, err := folderRepo.NewPostgresRepository(dataSourceName)
+	if err != nil {
+		log.Fatalf("Failed to init folder repo: %v", err)
+	}
 	loginItemRepository, err := loginItemRepo.NewPostgresRepository(dataSourceName, itemRepository, itemRepository)
 	if err != nil {
 		log.Fatalf("Failed to init item repo: %v", err)
 	}
 
+	noteItemRepository, err := noteItemRepo.NewPostgresRepository(dataSourceName, itemRepository)
+	if err != nil {
+		log.Fatalf("Failed to init note item repo: %v", err)
+	}
+	cardItemRepository, err := cardItemRepo.NewPostgresRepository(dataSourceName, itemRepository)
+	if err != nil {
+		log.Fatalf("Failed to init card item repo: %v", err)
+	}
+	identityItemRepository, err := identityItemRepo.NewPostgresRepository(dataSourceName, itemRepository)
+	if err != nil {
+		log.Fatalf("Failed to init identity item repo: %v", err)
+	}
+	sshKeyItemRepository, err := sshKeyItemRepo.NewPostgresRepository(dataSourceName, itemRepository)
+	if err != nil {
+		log.Fatalf("Failed to init ssh key item repo: %v", err)
+	}
+	apiKeyItemRepository, err := apiKeyItemRepo.NewPostgresRepository(dataSourceName, itemRepository)
+	if err != nil {
+		log.Fatalf("Failed to init api key item repo: %v", err)
+	}
+
+	masterKeyring, err := aes.ParseKeyring(cfg.Crypto.MasterKeyVersion, cfg.Crypto.MasterKey, cfg.Crypto.PreviousMasterKeys)
+	if err != nil {
+		log.Fatalf("Failed to init master keys: %v", err)
+	}
+
+	if !jwt.SupportedAlgorithm(cfg.Signing.Algorithm) {
+		log.Fatalf("Unsupported JWT signing algorithm %q", cfg.Signing.Algorithm)
+	}
+	if cfg.Signing.PublishAhead >= cfg.Signing.RotationInterval {
+		log.Fatalf("Signing key publish_ahead must be shorter than rotation_interval")
+	}
+
+	if cfg.OAuth.Issuer != "" {
+		issuer, err := url.Parse(cfg.OAuth.Issuer)
+		if err != nil || issuer.Host == "" || (issuer.Scheme != "https" && issuer.Scheme != "http") || issuer.RawQuery != "" || issuer.Fragment != "" {
+			log.Fatalf("OAuth issuer must be an http(s) URL without query and fragment")
+		}
+	}
+
+	if cfg.ZeroKnowledge.Enabled && cfg.ZeroKnowledge.SaltSecret == "" {
+		log.Fatalf("KDF_SALT_SECRET is required in zero-knowledge mode")
+	}
+
+	var breachCorpus hibp.Corpus
+	if cfg.Breach.CorpusPath != "" {
+		breachCorpus, err = hibp.Open(cfg.Breach.CorpusPath, cfg.Breach.RefreshInterval)
+		if err != nil {
+			log.Fatalf("Failed to load breach corpus: %v", err)
+		}
+	}
+
 	logSlog := slog.New(
 		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
 	)
 
-	newItem := item.New(logSlog, itemRepository)
-	newLoginItem := loginItem.New(logSlog, loginItemRepository, loginItemRepository)
-	newAuth := auth.New(logSlog, userRepository, userRepository, appRepository, cfg.TokenTTL)
+	newKeys := keys.New(logSlog, masterKeyring, userRepository, userRepository, userRepository)
+	newItem := item.New(logSlog, itemRepository, itemRepository)
+	newLoginItem := loginItem.New(logSlog, loginItemRepository, loginItemRepository, newKeys, userRepository, cfg.Vault.PasswordHistorySize)
+	newNoteItem := noteItem.New(logSlog, noteItemRepository, noteItemRepository, newKeys)
+	newCardItem := cardItem.New(logSlog, cardItemRepository, cardItemRepository, newKeys, userRepository)
+	newIdentityItem := identityItem.New(logSlog, identityItemRepository, identityItemRepository, newKeys)
+	newSSHKeyItem := sshKeyItem.New(logSlog, sshKeyItemRepository, sshKeyItemRepository, newKeys, userRepository)
+	newAPIKeyItem := apiKeyItem.New(logSlog, apiKeyItemRepository, apiKeyItemRepository, newKeys)
+	newFolder := folder.New(logSlog, folderRepository, folderRepository)
+	newGenerator := generator.New(logSlog)
+	newHealth := health.New(logSlog, newLoginItem, userRepository, cfg.Vault.MaxPasswordAge)
+	newBreach := breach.New(logSlog, breachCorpus, newLoginItem, userRepository, cfg.Breach.WarnOnCreate)
+	newRevocation := revocation.New(logSlog, revocationRepository, cfg.TokenTTL, cfg.RevocationSyncInterval)
+	newSigning := signing.New(logSlog, masterKeyring, signingKeyRepository, signing.Options{
+		Algorithm:        cfg.Signing.Algorithm,
+		RotationInterval: cfg.Signing.RotationInterval,
+		PublishAhead:     cfg.Signing.PublishAhead,
+		RefreshInterval:  cfg.Signing.CheckInterval,
+		TokenTTL:         cfg.TokenTTL,
+	})
+	newAPIToken := apiTokenService.New(logSlog, apiTokenRepository, folderRepository, cfg.APITokens.MaxTTL)
+	newApp := appService.New(logSlog, appRepository)
+	newAuth := auth.New(logSlog, userRepository, userRepository, newApp, newKeys, userRepository, newKeys, sessionRepository, newRevocation, newSigning, oauthRepository, auth.ZeroKnowledgeOptions{
+		Enabled:     cfg.ZeroKnowledge.Enabled,
+		SaltSecret:  []byte(cfg.ZeroKnowledge.SaltSecret),
+		Memory:      cfg.ZeroKnowledge.KdfMemory,
+		Iterations:  cfg.ZeroKnowledge.KdfIterations,
+		Parallelism: cfg.ZeroKnowledge.KdfParallelism,
+	}, auth.TwoFactorOptions{
+		Issuer:        cfg.TwoFactor.Issuer,
+		ChallengeTTL:  cfg.TwoFactor.ChallengeTTL,
+		MaxAttempts:   cfg.TwoFactor.MaxAttempts,
+		RecoveryCodes: cfg.TwoFactor.RecoveryCodes,
+		WebAuthn: webauthn.Config{
+			RPID:             cfg.TwoFactor.WebAuthn.RPID,
+			RPName:           cfg.TwoFactor.Issuer,
+			Origins:          cfg.TwoFactor.WebAuthn.Origins,
+			UserVerification: cfg.TwoFactor.WebAuthn.UserVerification,
+			Timeout:          cfg.TwoFactor.ChallengeTTL,
+		},
+	}, auth.OAuthOptions{
+		Issuer:  cfg.OAuth.Issuer,
+		CodeTTL: cfg.OAuth.CodeTTL,
+	}, cfg.TokenTTL, cfg.RefreshTokenTTL)
 
 	// Регистрация хендлеров
-	application := app.New(logSlog, newItem, newLoginItem, appRepository, newAuth, cfg.GRPC.Port)
+	application := app.New(logSlog, newItem, newLoginItem, newNoteItem, newCardItem, newIdentityItem,
+		newSSHKeyItem, newAPIKeyItem, newFolder, newGenerator, newHealth, newBreach, newApp, userRepository, newRevocation, newSigning, newAPIToken, newAuth, cfg.GRPC.Port, cfg.HTTP.Port, cfg.HTTP.Timeout,
+		cfg.Vault.TrashRetention, cfg.Vault.JanitorInterval, cfg.Vault.JanitorBatchSize, cfg.Signing.CheckInterval)
 	go func() {
 		application.GRPCServer.MustRun()
 	}()
+	go func() {
+		application.HTTPServer.MustRun()
+	}()
+	go application.Janitor.Run()
+	go application.KeyRotator.Run()
 	// Graceful shutdown
 
 	stop := make(chan os.Signal, 1)
@@ -76,6 +230,9 @@ func Start() {
 	<-stop
 
 	application.GRPCServer.Stop()
+	application.HTTPServer.Stop()
+	application.Janitor.Stop()
+	application.KeyRotator.Stop()
 	log.Info("Gracefully stopped")
 
 }
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/s0vunia/password-manager/internal/lib/jwt"
+	log "github.com/sirupsen/logrus"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// oauth-client is a stub relying party for trying out the OAuth provider mode locally.
+// It runs the authorization code flow with PKCE as the user of a session access token,
+// verifies the ID token against the published keys and prints the userinfo response:
+//
+//	go run ./cmd/oauth-client -client-id 1 -client-secret <secret> -token <access token>
+//
+// The redirect URI must be registered for the app with SetAppRedirectURIs, the stub
+// reads the code from the redirect instead of listening on it.
+func main() {
+	issuer := flag.String("issuer", "http://localhost:8080", "issuer of the provider")
+	clientId := flag.String("client-id", "", "app id")
+	clientSecret := flag.String("client-secret", "", "client secret of the app")
+	redirectURI := flag.String("redirect-uri", "http://localhost:9999/callback", "registered redirect uri")
+	scope := flag.String("scope", "openid profile", "requested scope")
+	accessToken := flag.String("token", "", "access token of the user's session")
+	flag.Parse()
+	if *clientId == "" || *clientSecret == "" || *accessToken == "" {
+		log.Fatalf("-client-id, -client-secret and -token are required")
+	}
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+
+	var config struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		UserInfoEndpoint      string `json:"userinfo_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+	if err := getJSON(client, strings.TrimSuffix(*issuer, "/")+"/.well-known/openid-configuration", "", &config); err != nil {
+		log.Fatalf("Failed to discover provider: %v", err)
+	}
+
+	verifier, state, nonce := random(), random(), random()
+	sum := sha256.Sum256([]byte(verifier))
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {*clientId},
+		"redirect_uri":          {*redirectURI},
+		"scope":                 {*scope},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(sum[:])},
+		"code_challenge_method": {"S256"},
+	}
+	req, _ := http.NewRequest(http.MethodGet, config.AuthorizationEndpoint+"?"+query.Encode(), nil)
+	req.Header.Set("Authorization", "Bearer "+*accessToken)
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Fatalf("Failed to authorize: %v", err)
+	}
+	resp.Body.Close()
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if resp.StatusCode != http.StatusFound || err != nil {
+		log.Fatalf("Authorization failed with status %d", resp.StatusCode)
+	}
+	callback := location.Query()
+	if callback.Get("state") != state {
+		log.Fatalf("State of the redirect doesn't match")
+	}
+	if callback.Get("error") != "" {
+		log.Fatalf("Authorization failed: %s: %s", callback.Get("error"), callback.Get("error_description"))
+	}
+	log.Infof("Redirected to %s", location.Redacted())
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {callback.Get("code")},
+		"redirect_uri":  {*redirectURI},
+		"code_verifier": {verifier},
+	}
+	req, _ = http.NewRequest(http.MethodPost, config.TokenEndpoint, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(*clientId), url.QueryEscape(*clientSecret))
+	var tokens struct {
+		AccessToken string `json:"access_token"`
+		IDToken     string `json:"id_token"`
+		Error       string `json:"error"`
+	}
+	resp, err = client.Do(req)
+	if err != nil {
+		log.Fatalf("Failed to exchange code: %v", err)
+	}
+	err = json.NewDecoder(resp.Body).Decode(&tokens)
+	resp.Body.Close()
+	if err != nil || resp.StatusCode != http.StatusOK {
+		log.Fatalf("Code exchange failed with status %d: %s", resp.StatusCode, tokens.Error)
+	}
+
+	var jwks jwt.JWKS
+	if err := getJSON(client, config.JWKSURI, "", &jwks); err != nil {
+		log.Fatalf("Failed to get keys: %v", err)
+	}
+	claims := gojwt.MapClaims{}
+	_, err = gojwt.ParseWithClaims(tokens.IDToken, claims, func(token *gojwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		for _, key := range jwks.Keys {
+			if key.Kid == kid {
+				return publicKey(key)
+			}
+		}
+		return nil, jwt.ErrUnknownKey
+	}, gojwt.WithIssuer(config.Issuer), gojwt.WithAudience(*clientId))
+	if err != nil {
+		log.Fatalf("Invalid ID token: %v", err)
+	}
+	if claims["nonce"] != nonce {
+		log.Fatalf("Nonce of the ID token doesn't match")
+	}
+	log.Infof("ID token of %v is valid: %v", claims["sub"], claims)
+
+	var userInfo map[string]any
+	if err := getJSON(client, config.UserInfoEndpoint, tokens.AccessToken, &userInfo); err != nil {
+		log.Fatalf("Failed to get user info: %v", err)
+	}
+	log.Infof("User info: %v", userInfo)
+}
+
+func getJSON(client *http.Client, endpoint string, accessToken string, v any) error {
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", endpoint, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func publicKey(key jwt.JWK) (interface{}, error) {
+	switch key.Kty {
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(key.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	}
+	return nil, jwt.ErrUnsupportedAlgorithm
+}
+
+func random() string {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		log.Fatalf("Failed to generate random value: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
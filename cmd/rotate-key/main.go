@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/s0vunia/password-manager/internal/config"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	signingKeyRepo "github.com/s0vunia/password-manager/internal/repositories/signingKey"
+	"github.com/s0vunia/password-manager/internal/repositories/user"
+	"github.com/s0vunia/password-manager/internal/services/keys"
+	"github.com/s0vunia/password-manager/internal/services/signing"
+	log "github.com/sirupsen/logrus"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// rotate-key re-wraps every user data key and token signing key with the current master key version.
+// Run it after deploying config with the new MASTER_KEY and the old one in
+// PREVIOUS_MASTER_KEYS; servers keep serving both versions meanwhile.
+// It is safe to interrupt and run again, the rotation resumes from the last batch.
+func main() {
+	cfg := config.MustLoad()
+	dataSourceName := fmt.Sprintf("host=%s port=%s dbname=%s user=%s password=%s sslmode=disable",
+		cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.DbName, cfg.Postgres.User, cfg.Postgres.Password)
+	userRepository, err := user.NewPostgresRepository(dataSourceName)
+	if err != nil {
+		log.Fatalf("Failed to init user repo: %v", err)
+	}
+	signingKeyRepository, err := signingKeyRepo.NewPostgresRepository(dataSourceName)
+	if err != nil {
+		log.Fatalf("Failed to init signing key repo: %v", err)
+	}
+
+	masterKeyring, err := aes.ParseKeyring(cfg.Crypto.MasterKeyVersion, cfg.Crypto.MasterKey, cfg.Crypto.PreviousMasterKeys)
+	if err != nil {
+		log.Fatalf("Failed to init master keys: %v", err)
+	}
+
+	logSlog := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	newKeys := keys.New(logSlog, masterKeyring, userRepository, userRepository, userRepository)
+	rewrapped, err := newKeys.RotateMasterKey(ctx, cfg.Crypto.RotationBatchSize)
+	if err != nil {
+		log.Fatalf("Rotation stopped after %d keys: %v", rewrapped, err)
+	}
+	log.Infof("Rotated %d keys to master key version %d", rewrapped, cfg.Crypto.MasterKeyVersion)
+
+	newSigning := signing.New(logSlog, masterKeyring, signingKeyRepository, signing.Options{})
+	resealed, err := newSigning.ResealKeys(ctx)
+	if err != nil {
+		log.Fatalf("Failed to reseal signing keys: %v", err)
+	}
+	log.Infof("Resealed %d signing keys", resealed)
+}
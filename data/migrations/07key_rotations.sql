@@ -0,0 +1,11 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS key_version INT NOT NULL DEFAULT 1;
+
+CREATE TABLE IF NOT EXISTS key_rotations
+(
+    id             BIGSERIAL PRIMARY KEY,
+    target_version INT       NOT NULL,
+    rewrapped      BIGINT    NOT NULL DEFAULT 0,
+    started_at     TIMESTAMP NOT NULL DEFAULT now(),
+    completed_at   TIMESTAMP
+);
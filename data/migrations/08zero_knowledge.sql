@@ -0,0 +1,6 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS kdf_algorithm   VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS kdf_salt        BYTEA,
+    ADD COLUMN IF NOT EXISTS kdf_memory      INT,
+    ADD COLUMN IF NOT EXISTS kdf_iterations  INT,
+    ADD COLUMN IF NOT EXISTS kdf_parallelism SMALLINT;
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS note_items (
+    id UUID PRIMARY KEY,
+    item_id UUID REFERENCES items (id) ON DELETE CASCADE,
+    note TEXT
+)
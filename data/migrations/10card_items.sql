@@ -0,0 +1,13 @@
+ALTER TABLE items
+    ADD COLUMN IF NOT EXISTS summary VARCHAR(100);
+
+CREATE TABLE IF NOT EXISTS card_items (
+    id UUID PRIMARY KEY,
+    item_id UUID REFERENCES items (id) ON DELETE CASCADE,
+    cardholder TEXT,
+    number TEXT,
+    expiry_month SMALLINT,
+    expiry_year SMALLINT,
+    cvv TEXT,
+    brand VARCHAR(20)
+)
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS identity_items (
+    id UUID PRIMARY KEY,
+    item_id UUID REFERENCES items (id) ON DELETE CASCADE,
+    title VARCHAR(20),
+    first_name VARCHAR(100),
+    middle_name VARCHAR(100),
+    last_name VARCHAR(100),
+    address1 VARCHAR(200),
+    address2 VARCHAR(200),
+    city VARCHAR(100),
+    state VARCHAR(100),
+    postal_code VARCHAR(20),
+    country VARCHAR(100),
+    phone VARCHAR(50),
+    email VARCHAR(200),
+    passport_number TEXT,
+    national_id TEXT,
+    license_number TEXT
+)
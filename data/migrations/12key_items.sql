@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS ssh_key_items (
+    id UUID PRIMARY KEY,
+    item_id UUID REFERENCES items (id) ON DELETE CASCADE,
+    private_key TEXT,
+    passphrase TEXT,
+    public_key TEXT,
+    key_type VARCHAR(50),
+    fingerprint VARCHAR(100),
+    comment VARCHAR(200)
+);
+
+CREATE TABLE IF NOT EXISTS api_key_items (
+    id UUID PRIMARY KEY,
+    item_id UUID REFERENCES items (id) ON DELETE CASCADE,
+    key_id VARCHAR(200),
+    secret TEXT,
+    service VARCHAR(200),
+    expires_at TIMESTAMP
+);
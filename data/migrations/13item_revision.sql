@@ -0,0 +1,2 @@
+ALTER TABLE items
+    ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,4 @@
+ALTER TABLE folders
+    ALTER COLUMN id SET DEFAULT gen_random_uuid();
+
+CREATE UNIQUE INDEX IF NOT EXISTS folders_user_id_name_idx ON folders (user_id, name);
@@ -0,0 +1,9 @@
+ALTER TABLE folders
+    ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES folders (id);
+
+CREATE INDEX IF NOT EXISTS folders_parent_id_idx ON folders (parent_id);
+
+-- Folder names are unique among siblings, root folders included.
+DROP INDEX IF EXISTS folders_user_id_name_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS folders_user_id_parent_id_name_idx
+    ON folders (user_id, COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::uuid), name);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS login_item_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    login_item_id UUID REFERENCES login_items (id) ON DELETE CASCADE,
+    login VARCHAR(50),
+    encrypt_password TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS login_item_history_login_item_id_idx ON login_item_history (login_item_id, created_at DESC);
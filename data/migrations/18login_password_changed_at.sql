@@ -0,0 +1,8 @@
+ALTER TABLE login_items
+    ADD COLUMN IF NOT EXISTS password_changed_at TIMESTAMP NOT NULL DEFAULT now();
+
+-- The last history snapshot was taken when the password was changed for the last time.
+UPDATE login_items
+SET password_changed_at = history.changed_at
+FROM (SELECT login_item_id, max(created_at) AS changed_at FROM login_item_history GROUP BY login_item_id) AS history
+WHERE history.login_item_id = login_items.id;
@@ -0,0 +1,2 @@
+ALTER TABLE login_items
+    ADD COLUMN IF NOT EXISTS encrypt_totp TEXT;
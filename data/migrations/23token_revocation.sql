@@ -0,0 +1,18 @@
+-- Admins are granted with cmd/grant-admin.
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS is_admin BOOLEAN NOT NULL DEFAULT false;
+
+-- id is a token id (jti) or a session id, times are unix milliseconds.
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    expires_at BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS revoked_tokens_expires_at_idx ON revoked_tokens (expires_at);
+
+-- Tokens of the user issued at or before revoked_before are rejected.
+CREATE TABLE IF NOT EXISTS user_token_revocations (
+    user_id UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    revoked_before BIGINT NOT NULL
+);
@@ -0,0 +1,11 @@
+-- id is the kid of the key, private_key is PKCS #8 sealed with the master key of key_version.
+-- Times are unix milliseconds, retires_at is set once a newer key replaces the key.
+CREATE TABLE IF NOT EXISTS signing_keys (
+    id TEXT PRIMARY KEY,
+    algorithm TEXT NOT NULL,
+    private_key TEXT NOT NULL,
+    key_version INT NOT NULL,
+    created_at BIGINT NOT NULL,
+    activates_at BIGINT NOT NULL,
+    retires_at BIGINT
+);
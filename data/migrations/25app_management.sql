@@ -0,0 +1,12 @@
+-- Secrets of apps are sealed with the master key of secret_key_version,
+-- NULL marks a plaintext secret seeded before, it gets sealed by rotating the secret or by cmd/rotate-key.
+ALTER TABLE apps
+    ADD COLUMN IF NOT EXISTS secret_key_version INT,
+    ADD COLUMN IF NOT EXISTS disabled BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS secret_rotated_at TIMESTAMP NOT NULL DEFAULT now();
+
+CREATE UNIQUE INDEX IF NOT EXISTS apps_name_idx ON apps (name);
+
+-- The seeded app was inserted with an explicit id, move the sequence past it.
+SELECT setval(pg_get_serial_sequence('apps', 'id'), COALESCE(MAX(id), 1)) FROM apps;
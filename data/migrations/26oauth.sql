@@ -0,0 +1,21 @@
+-- Redirect URIs an app may receive authorization codes at, compared exactly.
+CREATE TABLE IF NOT EXISTS app_redirect_uris (
+    app_id BIGINT NOT NULL REFERENCES apps (id) ON DELETE CASCADE,
+    uri TEXT NOT NULL,
+    PRIMARY KEY (app_id, uri)
+);
+
+-- auth_time is the unix time the user authenticated at.
+CREATE TABLE IF NOT EXISTS oauth_codes (
+    code_hash BYTEA PRIMARY KEY,
+    app_id BIGINT NOT NULL REFERENCES apps (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    redirect_uri TEXT NOT NULL,
+    scope TEXT NOT NULL,
+    nonce TEXT NOT NULL DEFAULT '',
+    code_challenge TEXT NOT NULL,
+    auth_time BIGINT NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS oauth_codes_expires_at_idx ON oauth_codes (expires_at);
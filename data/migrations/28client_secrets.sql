@@ -0,0 +1,7 @@
+-- Apps authenticate with a client secret stored as its SHA-256 hash, the secret itself is shown
+-- once and never signs or encrypts anything. Secrets stored before are dropped, existing apps
+-- get a new one with RotateAppSecret before they can use OAuth.
+ALTER TABLE apps
+    ADD COLUMN IF NOT EXISTS client_secret_hash BYTEA,
+    DROP COLUMN IF EXISTS secret,
+    DROP COLUMN IF EXISTS secret_key_version;
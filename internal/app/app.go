@@ -2,27 +2,72 @@ package app
 
 import (
 	grpcapp "github.com/s0vunia/password-manager/internal/app/grpc"
-	"github.com/s0vunia/password-manager/internal/repositories/app"
+	httpapp "github.com/s0vunia/password-manager/internal/app/http"
+	janitorapp "github.com/s0vunia/password-manager/internal/app/janitor"
+	signingapp "github.com/s0vunia/password-manager/internal/app/signing"
+	"github.com/s0vunia/password-manager/internal/repositories/user"
+	apiTokenService "github.com/s0vunia/password-manager/internal/services/apiToken"
+	appService "github.com/s0vunia/password-manager/internal/services/app"
 	"github.com/s0vunia/password-manager/internal/services/auth"
+	"github.com/s0vunia/password-manager/internal/services/manager/apiKeyItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/breach"
+	"github.com/s0vunia/password-manager/internal/services/manager/cardItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/folder"
+	"github.com/s0vunia/password-manager/internal/services/manager/generator"
+	"github.com/s0vunia/password-manager/internal/services/manager/health"
+	"github.com/s0vunia/password-manager/internal/services/manager/identityItem"
 	"github.com/s0vunia/password-manager/internal/services/manager/item"
 	"github.com/s0vunia/password-manager/internal/services/manager/loginItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/noteItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/sshKeyItem"
+	"github.com/s0vunia/password-manager/internal/services/revocation"
+	"github.com/s0vunia/password-manager/internal/services/signing"
 	"log/slog"
+	"time"
 )
 
 type App struct {
 	GRPCServer *grpcapp.App
+	HTTPServer *httpapp.App
+	Janitor    *janitorapp.App
+	KeyRotator *signingapp.App
 }
 
 func New(
 	log *slog.Logger,
 	item item.IItemService,
 	loginItem loginItem.ILoginItemService,
-	appRepo app.Repository,
+	noteItem noteItem.INoteItemService,
+	cardItem cardItem.ICardItemService,
+	identityItem identityItem.IIdentityItemService,
+	sshKeyItem sshKeyItem.ISSHKeyItemService,
+	apiKeyItem apiKeyItem.IAPIKeyItemService,
+	folder folder.IFolderService,
+	generator generator.IGeneratorService,
+	health health.IHealthService,
+	breach breach.IBreachService,
+	apps appService.IAppService,
+	userRepo user.Repository,
+	revocations revocation.IRevocationService,
+	signingKeys signing.ISigningService,
+	apiTokens apiTokenService.IAPITokenService,
 	auth auth.IOAuth,
 	grpcPort int,
+	httpPort int,
+	httpTimeout time.Duration,
+	trashRetention time.Duration,
+	janitorInterval time.Duration,
+	janitorBatchSize int,
+	keyCheckInterval time.Duration,
 ) *App {
-	grpcServer := grpcapp.New(log, auth, item, loginItem, appRepo, grpcPort)
+	grpcServer := grpcapp.New(log, auth, item, loginItem, noteItem, cardItem, identityItem, sshKeyItem, apiKeyItem, folder, generator, health, breach, apps, userRepo, revocations, signingKeys, apiTokens, grpcPort)
+	httpServer := httpapp.New(log, auth, httpPort, httpTimeout)
+	janitor := janitorapp.New(log, item, trashRetention, janitorInterval, janitorBatchSize)
+	keyRotator := signingapp.New(log, signingKeys, keyCheckInterval)
 	return &App{
 		GRPCServer: grpcServer,
+		HTTPServer: httpServer,
+		Janitor:    janitor,
+		KeyRotator: keyRotator,
 	}
 }
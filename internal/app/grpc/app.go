@@ -8,12 +8,26 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
+	"github.com/s0vunia/password-manager/internal/domain"
 	authgrpc "github.com/s0vunia/password-manager/internal/grpc/auth"
 	managergrpc "github.com/s0vunia/password-manager/internal/grpc/manager"
-	"github.com/s0vunia/password-manager/internal/repositories/app"
+	"github.com/s0vunia/password-manager/internal/repositories/user"
+	apiTokenService "github.com/s0vunia/password-manager/internal/services/apiToken"
+	appService "github.com/s0vunia/password-manager/internal/services/app"
 	authService "github.com/s0vunia/password-manager/internal/services/auth"
+	"github.com/s0vunia/password-manager/internal/services/manager/apiKeyItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/breach"
+	"github.com/s0vunia/password-manager/internal/services/manager/cardItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/folder"
+	"github.com/s0vunia/password-manager/internal/services/manager/generator"
+	"github.com/s0vunia/password-manager/internal/services/manager/health"
+	"github.com/s0vunia/password-manager/internal/services/manager/identityItem"
 	"github.com/s0vunia/password-manager/internal/services/manager/item"
 	"github.com/s0vunia/password-manager/internal/services/manager/loginItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/noteItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/sshKeyItem"
+	"github.com/s0vunia/password-manager/internal/services/revocation"
+	"github.com/s0vunia/password-manager/internal/services/signing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,6 +46,132 @@ var (
 		"/manager.Manager/GetLoginItems",
 		"/manager.Manager/GetItemsByFolder",
 		"/manager.Manager/DeleteLoginItem",
+		"/manager.Manager/UpdateItem",
+		"/manager.Manager/UpdateLoginItem",
+		"/manager.Manager/GetLoginItemHistory",
+		"/manager.Manager/RestoreLoginItemPassword",
+		"/manager.Manager/CreateFolder",
+		"/manager.Manager/GetFolders",
+		"/manager.Manager/GetFolderByPath",
+		"/manager.Manager/RenameFolder",
+		"/manager.Manager/MoveFolder",
+		"/manager.Manager/DeleteFolder",
+		"/manager.Manager/CreateNoteItem",
+		"/manager.Manager/GetNoteItem",
+		"/manager.Manager/GetNoteItems",
+		"/manager.Manager/UpdateNoteItem",
+		"/manager.Manager/DeleteNoteItem",
+		"/manager.Manager/CreateCardItem",
+		"/manager.Manager/GetCardItem",
+		"/manager.Manager/GetCardItems",
+		"/manager.Manager/UpdateCardItem",
+		"/manager.Manager/DeleteCardItem",
+		"/manager.Manager/CreateIdentityItem",
+		"/manager.Manager/GetIdentityItem",
+		"/manager.Manager/GetIdentityItems",
+		"/manager.Manager/UpdateIdentityItem",
+		"/manager.Manager/DeleteIdentityItem",
+		"/manager.Manager/CreateSSHKeyItem",
+		"/manager.Manager/GetSSHKeyItem",
+		"/manager.Manager/GetSSHKeyItems",
+		"/manager.Manager/UpdateSSHKeyItem",
+		"/manager.Manager/DeleteSSHKeyItem",
+		"/manager.Manager/CreateAPIKeyItem",
+		"/manager.Manager/GetAPIKeyItem",
+		"/manager.Manager/GetAPIKeyItems",
+		"/manager.Manager/UpdateAPIKeyItem",
+		"/manager.Manager/DeleteAPIKeyItem",
+		"/manager.Manager/ListTrash",
+		"/manager.Manager/RestoreItem",
+		"/manager.Manager/PurgeItem",
+		"/manager.Manager/GeneratePassword",
+		"/manager.Manager/GetVaultHealth",
+		"/manager.Manager/CheckBreaches",
+		"/manager.Manager/GetTOTPCode",
+		"/auth.Auth/EnrollTOTP",
+		"/auth.Auth/ConfirmTOTP",
+		"/auth.Auth/DisableTOTP",
+		"/auth.Auth/BeginWebAuthnRegistration",
+		"/auth.Auth/FinishWebAuthnRegistration",
+		"/auth.Auth/ListWebAuthnCredentials",
+		"/auth.Auth/DeleteWebAuthnCredential",
+		"/auth.Auth/ListSessions",
+		"/auth.Auth/RevokeSession",
+		"/auth.Auth/Logout",
+		"/auth.Auth/LogoutEverywhere",
+		"/auth.Auth/RevokeUserTokens",
+		"/auth.Auth/RegisterApp",
+		"/auth.Auth/ListApps",
+		"/auth.Auth/RotateAppSecret",
+		"/auth.Auth/SetAppDisabled",
+		"/auth.Auth/DeleteApp",
+		"/auth.Auth/SetAppRedirectURIs",
+		"/auth.Auth/CreateAPIToken",
+		"/auth.Auth/ListAPITokens",
+		"/auth.Auth/RevokeAPIToken",
+	}
+	// listOfRoutesAdmin must be a subset of listOfRoutesJWTMiddleware.
+	listOfRoutesAdmin = []string{
+		"/auth.Auth/RevokeUserTokens",
+		"/auth.Auth/RegisterApp",
+		"/auth.Auth/ListApps",
+		"/auth.Auth/RotateAppSecret",
+		"/auth.Auth/SetAppDisabled",
+		"/auth.Auth/DeleteApp",
+		"/auth.Auth/SetAppRedirectURIs",
+	}
+	// apiTokenRoutes are RPCs API tokens can call and the scope each needs, they must be
+	// in listOfRoutesJWTMiddleware. Tokens limited to a folder can call FolderScoped ones only.
+	apiTokenRoutes = map[string]authgrpc.APITokenRoute{
+		"/manager.Manager/GetItem":                  {Scope: domain.APITokenScopeRead, FolderScoped: true},
+		"/manager.Manager/GetItemsByFolder":         {Scope: domain.APITokenScopeRead, FolderScoped: true},
+		"/manager.Manager/GetLoginItem":             {Scope: domain.APITokenScopeRead, FolderScoped: true},
+		"/manager.Manager/GetNoteItem":              {Scope: domain.APITokenScopeRead, FolderScoped: true},
+		"/manager.Manager/GetCardItem":              {Scope: domain.APITokenScopeRead, FolderScoped: true},
+		"/manager.Manager/GetIdentityItem":          {Scope: domain.APITokenScopeRead, FolderScoped: true},
+		"/manager.Manager/GetSSHKeyItem":            {Scope: domain.APITokenScopeRead, FolderScoped: true},
+		"/manager.Manager/GetAPIKeyItem":            {Scope: domain.APITokenScopeRead, FolderScoped: true},
+		"/manager.Manager/GetItems":                 {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GetLoginItems":            {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GetLoginItemHistory":      {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GetFolders":               {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GetFolderByPath":          {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GetNoteItems":             {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GetCardItems":             {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GetIdentityItems":         {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GetSSHKeyItems":           {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GetAPIKeyItems":           {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/ListTrash":                {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GeneratePassword":         {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GetVaultHealth":           {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/CheckBreaches":            {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/GetTOTPCode":              {Scope: domain.APITokenScopeRead},
+		"/manager.Manager/CreateLoginItem":          {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/UpdateItem":               {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/UpdateLoginItem":          {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/DeleteLoginItem":          {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/RestoreLoginItemPassword": {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/CreateFolder":             {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/RenameFolder":             {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/MoveFolder":               {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/DeleteFolder":             {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/CreateNoteItem":           {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/UpdateNoteItem":           {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/DeleteNoteItem":           {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/CreateCardItem":           {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/UpdateCardItem":           {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/DeleteCardItem":           {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/CreateIdentityItem":       {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/UpdateIdentityItem":       {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/DeleteIdentityItem":       {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/CreateSSHKeyItem":         {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/UpdateSSHKeyItem":         {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/DeleteSSHKeyItem":         {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/CreateAPIKeyItem":         {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/UpdateAPIKeyItem":         {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/DeleteAPIKeyItem":         {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/RestoreItem":              {Scope: domain.APITokenScopeWrite},
+		"/manager.Manager/PurgeItem":                {Scope: domain.APITokenScopeWrite},
 	}
 )
 
@@ -46,15 +186,27 @@ func New(
 	authService authService.IOAuth,
 	itemService item.IItemService,
 	loginItemService loginItem.ILoginItemService,
-	appRepo app.Repository,
+	noteItemService noteItem.INoteItemService,
+	cardItemService cardItem.ICardItemService,
+	identityItemService identityItem.IIdentityItemService,
+	sshKeyItemService sshKeyItem.ISSHKeyItemService,
+	apiKeyItemService apiKeyItem.IAPIKeyItemService,
+	folderService folder.IFolderService,
+	generatorService generator.IGeneratorService,
+	healthService health.IHealthService,
+	breachService breach.IBreachService,
+	apps appService.IAppService,
+	userRepo user.Repository,
+	revocations revocation.IRevocationService,
+	signingKeys signing.ISigningService,
+	apiTokens apiTokenService.IAPITokenService,
 	port int,
 
 ) *App {
 	loggingOpts := []logging.Option{
-		logging.WithLogOnEvents(
-			//logging.StartCall, logging.FinishCall,
-			logging.PayloadReceived, logging.PayloadSent,
-		),
+		// Payloads aren't logged: requests and responses carry passwords, TOTP and recovery codes,
+		// client secrets, OAuth codes and tokens.
+		logging.WithLogOnEvents(logging.FinishCall),
 		// Add any other option (check functions starting with logging.With).
 	}
 
@@ -72,10 +224,12 @@ func New(
 		grpc.ChainUnaryInterceptor(
 			recovery.UnaryServerInterceptor(recoveryOpts...),
 			logging.UnaryServerInterceptor(InterceptorLogger(log), loggingOpts...),
-			selector.UnaryServerInterceptor(authgrpc.JWTMiddleware(appRepo), selector.MatchFunc(checkGrpcNameForJWT)),
+			selector.UnaryServerInterceptor(authgrpc.JWTMiddleware(apps, signingKeys, revocations, apiTokens, apiTokenRoutes), selector.MatchFunc(checkGrpcNameForJWT)),
+			selector.UnaryServerInterceptor(authgrpc.AdminMiddleware(userRepo), selector.MatchFunc(checkGrpcNameForAdmin)),
 		))
-	authgrpc.Register(gRPCServer, authService)
-	managergrpc.Register(gRPCServer, itemService, loginItemService)
+	authgrpc.Register(gRPCServer, authService, apps, apiTokens)
+	managergrpc.Register(gRPCServer, itemService, loginItemService, noteItemService, cardItemService, identityItemService,
+		sshKeyItemService, apiKeyItemService, folderService, generatorService, healthService, breachService, userRepo)
 	return &App{
 		log:        log,
 		gRPCServer: gRPCServer,
@@ -128,7 +282,6 @@ func (a *App) Stop() {
 
 func checkGrpcNameForJWT(ctx context.Context, callMeta interceptors.CallMeta) bool {
 	fullMethName := callMeta.FullMethod()
-	log.Printf(fullMethName)
 	for _, name := range listOfRoutesJWTMiddleware {
 		if name == fullMethName {
 			return true
@@ -137,6 +290,16 @@ func checkGrpcNameForJWT(ctx context.Context, callMeta interceptors.CallMeta) bo
 	return false
 }
 
+func checkGrpcNameForAdmin(ctx context.Context, callMeta interceptors.CallMeta) bool {
+	fullMethName := callMeta.FullMethod()
+	for _, name := range listOfRoutesAdmin {
+		if name == fullMethName {
+			return true
+		}
+	}
+	return false
+}
+
 func getStackTrace() string {
 	buf := bytes.NewBuffer(nil)
 	if err := pprof.Lookup("goroutine").WriteTo(buf, 1); err != nil {
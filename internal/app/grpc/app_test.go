@@ -0,0 +1,28 @@
+package grpcapp
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAPITokenRoutesRequireAuthentication(t *testing.T) {
+	for method, route := range apiTokenRoutes {
+		if !slices.Contains(listOfRoutesJWTMiddleware, method) {
+			t.Errorf("%s is open to api tokens but isn't authenticated", method)
+		}
+		if slices.Contains(listOfRoutesAdmin, method) {
+			t.Errorf("%s is open to api tokens but is admin only", method)
+		}
+		if route.Scope == "" {
+			t.Errorf("%s is open to api tokens without a scope", method)
+		}
+	}
+}
+
+func TestAdminRoutesRequireAuthentication(t *testing.T) {
+	for _, method := range listOfRoutesAdmin {
+		if !slices.Contains(listOfRoutesJWTMiddleware, method) {
+			t.Errorf("%s is admin only but isn't authenticated", method)
+		}
+	}
+}
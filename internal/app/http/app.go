@@ -0,0 +1,76 @@
+package httpapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	authhttp "github.com/s0vunia/password-manager/internal/http/auth"
+	authService "github.com/s0vunia/password-manager/internal/services/auth"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// App serves endpoints that can't be expressed as gRPC calls of the Auth and
+// Manager services, e.g. the unauthenticated prelogin lookup.
+type App struct {
+	log        *slog.Logger
+	httpServer *http.Server
+	port       int
+}
+
+func New(
+	log *slog.Logger,
+	authService authService.IOAuth,
+	port int,
+	timeout time.Duration,
+) *App {
+	mux := http.NewServeMux()
+	authhttp.Register(mux, authService)
+
+	return &App{
+		log: log,
+		httpServer: &http.Server{
+			Addr:         fmt.Sprintf("0.0.0.0:%d", port),
+			Handler:      mux,
+			ReadTimeout:  timeout,
+			WriteTimeout: timeout,
+		},
+		port: port,
+	}
+}
+
+// MustRun runs HTTP server and panics if any error occurs.
+func (a *App) MustRun() {
+	if err := a.Run(); err != nil {
+		panic(err)
+	}
+}
+
+// Run runs HTTP server.
+func (a *App) Run() error {
+	const op = "httpapp.Run"
+
+	a.log.Info("http server started", slog.String("addr", a.httpServer.Addr))
+
+	if err := a.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Stop stops HTTP server.
+func (a *App) Stop() {
+	const op = "httpapp.Stop"
+
+	a.log.With(slog.String("op", op)).
+		Info("stopping HTTP server", slog.Int("port", a.port))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := a.httpServer.Shutdown(ctx); err != nil {
+		a.log.Error("failed to stop HTTP server", slog.String("error", err.Error()))
+	}
+}
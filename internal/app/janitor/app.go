@@ -0,0 +1,80 @@
+package janitorapp
+
+import (
+	"context"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"log/slog"
+	"time"
+)
+
+// Purger permanently deletes items which are in the trash longer than retention.
+type Purger interface {
+	PurgeExpired(ctx context.Context, retention time.Duration, batchSize int) (int64, error)
+}
+
+// App periodically empties expired items from users' trash.
+type App struct {
+	log       *slog.Logger
+	purger    Purger
+	retention time.Duration
+	interval  time.Duration
+	batchSize int
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+func New(
+	log *slog.Logger,
+	purger Purger,
+	retention time.Duration,
+	interval time.Duration,
+	batchSize int,
+) *App {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &App{
+		log:       log,
+		purger:    purger,
+		retention: retention,
+		interval:  interval,
+		batchSize: batchSize,
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+}
+
+// Run purges expired items right away and then every interval until Stop is called.
+func (a *App) Run() {
+	const op = "janitorapp.Run"
+
+	log := a.log.With(slog.String("op", op))
+	log.Info("janitor started", slog.Duration("retention", a.retention), slog.Duration("interval", a.interval))
+
+	defer close(a.done)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := a.purger.PurgeExpired(a.ctx, a.retention, a.batchSize); err != nil && a.ctx.Err() == nil {
+			log.Error("failed to purge trash", sl.Err(err))
+		}
+
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop interrupts the current purge and waits for Run to return.
+func (a *App) Stop() {
+	const op = "janitorapp.Stop"
+
+	a.log.With(slog.String("op", op)).Info("stopping janitor")
+
+	a.cancel()
+	<-a.done
+}
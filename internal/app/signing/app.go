@@ -0,0 +1,74 @@
+package signingapp
+
+import (
+	"context"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"log/slog"
+	"time"
+)
+
+// Rotator rotates token signing keys once they're due and deletes retired ones.
+type Rotator interface {
+	Rotate(ctx context.Context) error
+}
+
+// App periodically checks whether the token signing key has to be rotated.
+type App struct {
+	log      *slog.Logger
+	rotator  Rotator
+	interval time.Duration
+	ctx      context.Context
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func New(
+	log *slog.Logger,
+	rotator Rotator,
+	interval time.Duration,
+) *App {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &App{
+		log:      log,
+		rotator:  rotator,
+		interval: interval,
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+}
+
+// Run checks the keys right away and then every interval until Stop is called.
+func (a *App) Run() {
+	const op = "signingapp.Run"
+
+	log := a.log.With(slog.String("op", op))
+	log.Info("signing key rotator started", slog.Duration("interval", a.interval))
+
+	defer close(a.done)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.rotator.Rotate(a.ctx); err != nil && a.ctx.Err() == nil {
+			log.Error("failed to rotate signing keys", sl.Err(err))
+		}
+
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop interrupts the current check and waits for Run to return.
+func (a *App) Stop() {
+	const op = "signingapp.Stop"
+
+	a.log.With(slog.String("op", op)).Info("stopping signing key rotator")
+
+	a.cancel()
+	<-a.done
+}
@@ -11,12 +11,37 @@ type Config struct {
 	GRPC     GRPCConfig     `yaml:"grpc"`
 	Postgres PostgresConfig `yaml:"postgres"`
 	TokenTTL time.Duration  `yaml:"token_ttl" env-default:"1h"`
+	Crypto   CryptoConfig   `yaml:"crypto"`
+	HTTP     HTTPConfig     `yaml:"http"`
+	Vault    VaultConfig    `yaml:"vault"`
+	Breach   BreachConfig   `yaml:"breach"`
+
+	// RefreshTokenTTL is how long a session lasts without being refreshed.
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl" env:"REFRESH_TOKEN_TTL" env-default:"720h"`
+
+	// RevocationSyncInterval is how soon tokens revoked on another instance are rejected by this one.
+	RevocationSyncInterval time.Duration `yaml:"revocation_sync_interval" env:"REVOCATION_SYNC_INTERVAL" env-default:"10s"`
+
+	TwoFactor TwoFactorConfig `yaml:"two_factor"`
+
+	Signing SigningConfig `yaml:"signing"`
+
+	OAuth OAuthConfig `yaml:"oauth"`
+
+	APITokens APITokenConfig `yaml:"api_tokens"`
+
+	ZeroKnowledge ZeroKnowledgeConfig `yaml:"zero_knowledge"`
 }
 type GRPCConfig struct {
 	Port    int           `yaml:"port"`
 	Timeout time.Duration `yaml:"timeout"`
 }
 
+type HTTPConfig struct {
+	Port    int           `yaml:"port" env-default:"8080"`
+	Timeout time.Duration `yaml:"timeout" env-default:"5s"`
+}
+
 type PostgresConfig struct {
 	Host     string `yaml:"host"`
 	Port     string `yaml:"port"`
@@ -25,6 +50,97 @@ type PostgresConfig struct {
 	Password string `yaml:"password"`
 }
 
+// CryptoConfig holds key material for vault encryption.
+// MasterKey is a base64-encoded 32-byte key wrapping per-user data keys.
+// It should come from the environment, never from a committed config file.
+//
+// To rotate the master key, move the current key to PreviousMasterKeys
+// (e.g. PREVIOUS_MASTER_KEYS="1:<base64>"), set the new MasterKey with a bumped
+// MasterKeyVersion and run cmd/rotate-key. Previous keys can be dropped once it finishes.
+type CryptoConfig struct {
+	MasterKey          string         `yaml:"master_key" env:"MASTER_KEY" env-required:"true"`
+	MasterKeyVersion   int            `yaml:"master_key_version" env:"MASTER_KEY_VERSION" env-default:"1"`
+	PreviousMasterKeys map[int]string `yaml:"previous_master_keys" env:"PREVIOUS_MASTER_KEYS"`
+	RotationBatchSize  int            `yaml:"rotation_batch_size" env-default:"100"`
+}
+
+// VaultConfig holds retention policies of vault data.
+// PasswordHistorySize is the number of previous passwords kept per login item, 0 disables history.
+// Deleted items stay in the trash for TrashRetention, the janitor purges expired ones every JanitorInterval.
+// Health reports flag passwords not changed for MaxPasswordAge, 0 disables the check.
+type VaultConfig struct {
+	PasswordHistorySize int           `yaml:"password_history_size" env:"PASSWORD_HISTORY_SIZE" env-default:"10"`
+	TrashRetention      time.Duration `yaml:"trash_retention" env:"TRASH_RETENTION" env-default:"720h"`
+	JanitorInterval     time.Duration `yaml:"janitor_interval" env:"JANITOR_INTERVAL" env-default:"1h"`
+	JanitorBatchSize    int           `yaml:"janitor_batch_size" env-default:"500"`
+	MaxPasswordAge      time.Duration `yaml:"max_password_age" env:"MAX_PASSWORD_AGE" env-default:"2160h"`
+}
+
+// BreachConfig configures the offline breached password check.
+// CorpusPath is a directory of HIBP range files named <PREFIX>.txt or a single file of
+// HASH:COUNT lines, empty disables the check. The file is reloaded if it was modified
+// at most once per RefreshInterval, range directories are always read from disk.
+type BreachConfig struct {
+	CorpusPath      string        `yaml:"corpus_path" env:"BREACH_CORPUS_PATH"`
+	RefreshInterval time.Duration `yaml:"refresh_interval" env:"BREACH_REFRESH_INTERVAL" env-default:"1h"`
+	WarnOnCreate    bool          `yaml:"warn_on_create" env:"BREACH_WARN_ON_CREATE" env-default:"true"`
+}
+
+// SigningConfig configures keys access tokens are signed with. Algorithm is EdDSA or RS256,
+// other services verify tokens with the public keys published at /.well-known/jwks.json.
+// A key signs tokens for RotationInterval and the next one is published PublishAhead
+// before it takes over, rotation is checked every CheckInterval.
+type SigningConfig struct {
+	Algorithm        string        `yaml:"algorithm" env:"JWT_SIGNING_ALGORITHM" env-default:"EdDSA"`
+	RotationInterval time.Duration `yaml:"rotation_interval" env:"JWT_KEY_ROTATION_INTERVAL" env-default:"720h"`
+	PublishAhead     time.Duration `yaml:"publish_ahead" env-default:"24h"`
+	CheckInterval    time.Duration `yaml:"check_interval" env-default:"1m"`
+}
+
+// OAuthConfig enables the OAuth 2.0 / OpenID Connect provider mode, it's disabled if Issuer is empty.
+// Issuer is the public URL of the HTTP server, e.g. https://auth.example.com, clients must be
+// able to reach it. Authorization codes have to be exchanged within CodeTTL.
+type OAuthConfig struct {
+	Issuer  string        `yaml:"issuer" env:"OAUTH_ISSUER"`
+	CodeTTL time.Duration `yaml:"code_ttl" env-default:"1m"`
+}
+
+// APITokenConfig limits personal access tokens, they can't be valid for longer than MaxTTL.
+type APITokenConfig struct {
+	MaxTTL time.Duration `yaml:"max_ttl" env:"API_TOKEN_MAX_TTL" env-default:"8760h"`
+}
+
+// TwoFactorConfig configures TOTP two-factor authentication of user accounts.
+// Issuer is the account name shown in authenticator apps. After the password a user
+// has ChallengeTTL and MaxAttempts to enter the second factor before logging in again.
+type TwoFactorConfig struct {
+	Issuer        string        `yaml:"issuer" env:"TOTP_ISSUER" env-default:"Password Manager"`
+	ChallengeTTL  time.Duration `yaml:"challenge_ttl" env-default:"5m"`
+	MaxAttempts   int           `yaml:"max_attempts" env-default:"5"`
+	RecoveryCodes int           `yaml:"recovery_codes" env-default:"10"`
+
+	WebAuthn WebAuthnConfig `yaml:"webauthn"`
+}
+
+// WebAuthnConfig enables security keys as a second factor, they're disabled if RPID is empty.
+// RPID is the domain of the web vault, Origins are its full origins, e.g. https://vault.example.com.
+// UserVerification additionally requires a PIN or biometrics on the key.
+type WebAuthnConfig struct {
+	RPID             string   `yaml:"rp_id" env:"WEBAUTHN_RP_ID"`
+	Origins          []string `yaml:"origins" env:"WEBAUTHN_ORIGINS"`
+	UserVerification bool     `yaml:"user_verification" env:"WEBAUTHN_USER_VERIFICATION"`
+}
+
+// ZeroKnowledgeConfig enables zero-knowledge registration for new users.
+// Kdf* are Argon2id parameters published to clients, memory is in KiB.
+type ZeroKnowledgeConfig struct {
+	Enabled        bool   `yaml:"enabled" env:"ZERO_KNOWLEDGE"`
+	SaltSecret     string `yaml:"salt_secret" env:"KDF_SALT_SECRET"`
+	KdfMemory      uint32 `yaml:"kdf_memory" env-default:"65536"`
+	KdfIterations  uint32 `yaml:"kdf_iterations" env-default:"3"`
+	KdfParallelism uint8  `yaml:"kdf_parallelism" env-default:"4"`
+}
+
 func MustLoad() *Config {
 	configPath := fetchConfigPath()
 	if configPath == "" {
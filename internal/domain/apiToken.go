@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	// APITokenPrefix tells API tokens apart from JWTs.
+	APITokenPrefix = "pmat_"
+
+	APITokenScopeRead  = "vault:read"
+	APITokenScopeWrite = "vault:write"
+)
+
+// APIToken is a personal access token of a user for automation, e.g. CI jobs.
+// It grants Scopes only and, if FolderId is set, access to items of that folder only.
+type APIToken struct {
+	ID         uuid.UUID
+	UserId     uuid.UUID
+	Name       string
+	Scopes     []string
+	FolderId   *uuid.UUID
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+}
+
+func (t APIToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
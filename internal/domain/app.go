@@ -1,7 +1,16 @@
 package domain
 
+import "time"
+
+// App is a client allowed to log users in. SecretHash is the SHA-256 of the client secret
+// OAuth clients authenticate with, nil if the app has none; the secret itself isn't stored.
+// RedirectURIs are where the app receives OAuth authorization codes, they're loaded on demand.
 type App struct {
-	ID     int
-	Name   string
-	Secret string
+	ID              int
+	Name            string
+	SecretHash      []byte
+	Disabled        bool
+	CreatedAt       time.Time
+	SecretRotatedAt time.Time
+	RedirectURIs    []string
 }
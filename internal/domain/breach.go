@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// BreachFinding is a login item whose password is known from breaches, Count is how many times it was seen.
+type BreachFinding struct {
+	ItemId      uuid.UUID
+	LoginItemId uuid.UUID
+	Name        string
+	Count       int64
+}
+
+// BreachReport is the result of checking all login items of a vault, Checked is the number of items checked.
+type BreachReport struct {
+	Checked  int
+	Breached []*BreachFinding
+}
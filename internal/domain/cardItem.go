@@ -0,0 +1,14 @@
+package domain
+
+import "github.com/google/uuid"
+
+type CardItem struct {
+	Item
+	ID          uuid.UUID
+	Cardholder  string
+	Number      string
+	ExpiryMonth int
+	ExpiryYear  int
+	CVV         string
+	Brand       string
+}
@@ -2,8 +2,25 @@ package domain
 
 import "github.com/google/uuid"
 
+// FolderPathSeparator separates folder names in a path, e.g. infra/prod/db.
+const FolderPathSeparator = "/"
+
 type Folder struct {
 	ID     uuid.UUID
 	UserId uuid.UUID
 	Name   string
+	// ParentId is uuid.Nil for folders in the root of the vault.
+	ParentId uuid.UUID
+	// Path is the full path of the folder from the root, filled when listing folders.
+	Path string
 }
+
+// FolderDeleteMode tells what happens to items of the deleted folder subtree.
+type FolderDeleteMode int64
+
+const (
+	// FolderDeleteMoveToRoot keeps items, moving them to the root of the vault.
+	FolderDeleteMoveToRoot FolderDeleteMode = iota
+	// FolderDeleteCascade moves items to the trash together with the folders.
+	FolderDeleteCascade FolderDeleteMode = iota
+)
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type HealthIssue int
+
+const (
+	HealthIssueWeak HealthIssue = iota + 1
+	HealthIssueReused
+	HealthIssueOld
+)
+
+// ItemHealth is the health of a single login item password.
+// Items sharing a password have the same non-zero ReuseGroup.
+type ItemHealth struct {
+	ItemId      uuid.UUID
+	LoginItemId uuid.UUID
+	Name        string
+	Score       int
+	EntropyBits float64
+	Patterns    []string
+	ReuseGroup  int
+	PasswordAge time.Duration
+	Issues      []HealthIssue
+}
+
+// VaultHealth aggregates login item findings, Score is the percentage of items without issues.
+type VaultHealth struct {
+	Score  int
+	Total  int
+	Weak   int
+	Reused int
+	Old    int
+	Items  []*ItemHealth
+}
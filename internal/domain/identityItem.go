@@ -0,0 +1,25 @@
+package domain
+
+import "github.com/google/uuid"
+
+// IdentityItem holds personal and contact data used for form autofill.
+// PassportNumber, NationalId and LicenseNumber are encrypted at rest.
+type IdentityItem struct {
+	Item
+	ID             uuid.UUID
+	Title          string
+	FirstName      string
+	MiddleName     string
+	LastName       string
+	Address1       string
+	Address2       string
+	City           string
+	State          string
+	PostalCode     string
+	Country        string
+	Phone          string
+	Email          string
+	PassportNumber string
+	NationalId     string
+	LicenseNumber  string
+}
@@ -1,12 +1,19 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
 type ItemType int64
 
 const (
-	ItemTypeLogin ItemType = iota
-	ItemTypeNote  ItemType = iota
+	ItemTypeLogin    ItemType = iota
+	ItemTypeNote     ItemType = iota
+	ItemTypeCard     ItemType = iota
+	ItemTypeIdentity ItemType = iota
+	ItemTypeSSHKey   ItemType = iota
+	ItemTypeAPIKey   ItemType = iota
 )
 
 type Item struct {
@@ -16,4 +23,22 @@ type Item struct {
 	FolderId   uuid.UUID
 	UserId     uuid.UUID
 	IsFavorite bool
+	// Summary is a non-secret hint shown in item lists, e.g. masked card number.
+	Summary string
+	// Revision grows on every update and is used for optimistic concurrency.
+	Revision int64
+	// DeletedAt is set for items in the trash.
+	DeletedAt *time.Time
+}
+
+// ItemPatch is a partial update of item attributes, nil fields are left unchanged.
+// Revision must be equal to the stored one, otherwise the update is rejected,
+// so concurrent clients don't overwrite each other's changes.
+type ItemPatch struct {
+	ID         uuid.UUID
+	UserId     uuid.UUID
+	Revision   int64
+	Name       *string
+	FolderId   *uuid.UUID
+	IsFavorite *bool
 }
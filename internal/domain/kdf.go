@@ -0,0 +1,15 @@
+package domain
+
+// KDFAlgorithmArgon2id is the only key derivation function supported for zero-knowledge vaults.
+const KDFAlgorithmArgon2id = "argon2id"
+
+// KDFParams are per-user parameters clients use to derive the master key from
+// the master password. The server publishes them before login and never sees
+// the password or the master key itself, only the auth hash derived from it.
+type KDFParams struct {
+	Algorithm   string
+	Salt        []byte
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// UserKey is a user's data encryption key sealed with master key of Version.
+type UserKey struct {
+	UserId     uuid.UUID
+	WrappedKey string
+	Version    int
+}
+
+// KeyRotation tracks progress of re-wrapping user keys with a new master key version.
+type KeyRotation struct {
+	ID            int64
+	TargetVersion int
+	Rewrapped     int64
+	StartedAt     time.Time
+	CompletedAt   *time.Time
+}
+
+// SigningKey is a key access tokens are signed with, PrivateKey is sealed with master key of KeyVersion.
+// The key signs tokens from ActivatesAt until a newer key activates and is
+// published for verification until RetiresAt.
+type SigningKey struct {
+	ID          string
+	Algorithm   string
+	PrivateKey  string
+	KeyVersion  int
+	CreatedAt   time.Time
+	ActivatesAt time.Time
+	RetiresAt   *time.Time
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// SSHKeyItem stores SSH key pair. PrivateKey and Passphrase are encrypted at rest,
+// KeyType, PublicKey and Fingerprint are derived from PrivateKey by the server.
+type SSHKeyItem struct {
+	Item
+	ID          uuid.UUID
+	PrivateKey  string
+	Passphrase  string
+	PublicKey   string
+	KeyType     string
+	Fingerprint string
+	Comment     string
+}
+
+// APIKeyItem stores cloud or service API credentials. Secret is encrypted at rest.
+type APIKeyItem struct {
+	Item
+	ID        uuid.UUID
+	KeyId     string
+	Secret    string
+	Service   string
+	ExpiresAt *time.Time
+}
+
+// Expired reports whether the key has expiry date and it's passed at now.
+func (a APIKeyItem) Expired(now time.Time) bool {
+	return a.ExpiresAt != nil && !now.Before(*a.ExpiresAt)
+}
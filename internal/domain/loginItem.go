@@ -1,12 +1,28 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
+// LoginItem is a login with an optional TOTP second factor, EncryptTOTP is an otpauth:// URI.
 type LoginItem struct {
 	Item
+	ID                uuid.UUID
+	Login             string
+	EncryptPassword   string
+	EncryptTOTP       string
+	PasswordChangedAt time.Time
+}
+
+// LoginItemPatch is a partial update of login item, ID is the login item id.
+// Empty EncryptTOTP removes the TOTP secret.
+type LoginItemPatch struct {
+	ItemPatch
 	ID              uuid.UUID
-	Login           string
-	EncryptPassword string
+	Login           *string
+	EncryptPassword *string
+	EncryptTOTP     *string
 }
 
 func LoginItemToItem(model *LoginItem) *Item {
@@ -17,5 +33,23 @@ func LoginItemToItem(model *LoginItem) *Item {
 		FolderId:   model.FolderId,
 		UserId:     model.UserId,
 		IsFavorite: model.IsFavorite,
+		Summary:    model.Summary,
+		Revision:   model.Revision,
 	}
 }
+
+// LoginItemHistory is a snapshot of login item credentials taken before its password was changed.
+type LoginItemHistory struct {
+	ID              uuid.UUID
+	LoginItemId     uuid.UUID
+	Login           string
+	EncryptPassword string
+	CreatedAt       time.Time
+}
+
+// TOTPCode is the current code of a login item second factor, valid for Remaining out of Period.
+type TOTPCode struct {
+	Code      string
+	Remaining time.Duration
+	Period    time.Duration
+}
@@ -1,6 +1,9 @@
 package domain
 
+import "github.com/google/uuid"
+
 type NoteItem struct {
 	Item
-	note string
+	ID   uuid.UUID
+	Note string
 }
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+)
+
+// AuthorizationRequest is an OAuth 2.0 authorization request of the code flow with PKCE.
+type AuthorizationRequest struct {
+	ResponseType        string
+	ClientId            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+// AuthorizationCode is what an issued code grants, Scope is space separated.
+type AuthorizationCode struct {
+	AppId         int
+	UserId        uuid.UUID
+	Login         string
+	RedirectURI   string
+	Scope         string
+	Nonce         string
+	CodeChallenge string
+	AuthTime      time.Time
+}
+
+// TokenRequest is an access token request of the authorization code grant.
+type TokenRequest struct {
+	GrantType    string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	ClientId     string
+	ClientSecret string
+}
+
+type OAuthTokens struct {
+	AccessToken string
+	IDToken     string
+	ExpiresIn   time.Duration
+	Scope       string
+}
+
+// UserInfo holds claims about the user, PreferredUsername is set with the profile scope.
+type UserInfo struct {
+	Subject           string
+	PreferredUsername string
+}
+
+// OpenIDConfiguration is the OpenID Connect discovery metadata of the provider.
+type OpenIDConfiguration struct {
+	Issuer                string
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	UserInfoEndpoint      string
+	JWKSURI               string
+	SigningAlgorithm      string
+	Scopes                []string
+}
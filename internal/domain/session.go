@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Session is a login of a user on a device, it's renewed with a refresh token until it expires or is revoked.
+type Session struct {
+	ID         uuid.UUID
+	UserId     uuid.UUID
+	Login      string
+	AppId      int
+	DeviceName string
+	IP         string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+}
+
+// ClientInfo describes the client a session is used from.
+type ClientInfo struct {
+	DeviceName string
+	IP         string
+}
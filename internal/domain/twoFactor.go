@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// UserTOTP is the second factor of a user account, Secret is encrypted with the user's data key.
+// The secret is stored on enrollment, but it's checked on login only once Enabled.
+type UserTOTP struct {
+	UserId  uuid.UUID
+	Login   string
+	Secret  string
+	Enabled bool
+}
+
+// TOTPEnrollment is what an authenticator app needs to add the account,
+// QRCode is a PNG image encoding URI.
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+	QRCode []byte
+}
+
+type RecoveryCode struct {
+	ID   uuid.UUID
+	Hash []byte
+}
+
+const (
+	SecondFactorTOTP     = "totp"
+	SecondFactorWebAuthn = "webauthn"
+)
+
+// LoginChallenge is the pending second step of a login.
+// WebAuthnChallenge is set if the user has security keys.
+type LoginChallenge struct {
+	ID                uuid.UUID
+	UserId            uuid.UUID
+	AppId             int
+	Attempts          int
+	WebAuthnChallenge []byte
+}
+
+// LoginResult holds either the access and refresh tokens of a new session or, for users
+// with two-factor authentication, the challenge token to exchange for them with one of SecondFactors.
+// WebAuthnOptions are JSON options for navigator.credentials.get.
+type LoginResult struct {
+	Token           string
+	RefreshToken    string
+	SessionId       uuid.UUID
+	ChallengeToken  string
+	ChallengeTTL    time.Duration
+	SecondFactors   []string
+	WebAuthnOptions []byte
+}
@@ -6,4 +6,14 @@ type User struct {
 	ID       uuid.UUID
 	Login    string
 	PassHash []byte
+	// WrappedKey is the user's data encryption key sealed with the master key.
+	WrappedKey string
+	// KeyVersion is the master key version WrappedKey is sealed with.
+	KeyVersion int
+	// KDF is set for zero-knowledge users, their PassHash is a hash of the
+	// client-derived auth hash rather than of the master password.
+	KDF *KDFParams
+	// TOTPEnabled and WebAuthnEnabled require a second factor on login.
+	TOTPEnabled     bool
+	WebAuthnEnabled bool
 }
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// WebAuthnCredential is a security key registered as a second factor, PublicKey is a COSE_Key.
+type WebAuthnCredential struct {
+	ID           uuid.UUID
+	UserId       uuid.UUID
+	CredentialID []byte
+	PublicKey    []byte
+	SignCount    uint32
+	AAGUID       []byte
+	Name         string
+	CreatedAt    time.Time
+	LastUsedAt   *time.Time
+}
+
+// WebAuthnAssertion is the response of navigator.credentials.get.
+type WebAuthnAssertion struct {
+	CredentialID      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+}
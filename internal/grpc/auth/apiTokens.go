@@ -0,0 +1,115 @@
+package authgrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	apiTokenService "github.com/s0vunia/password-manager/internal/services/apiToken"
+	authv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (s *serverAPI) CreateAPIToken(
+	ctx context.Context,
+	in *authv1.CreateAPITokenRequest,
+) (*authv1.CreateAPITokenResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if in.GetExpiresIn() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "expires_in is required")
+	}
+	var folderId *uuid.UUID
+	if in.GetFolderId().GetValue() != "" {
+		id, err := uuid.Parse(in.GetFolderId().GetValue())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "folder_id is invalid")
+		}
+		folderId = &id
+	}
+
+	token, apiToken, err := s.apiTokens.CreateToken(ctx, userId, in.GetName(), in.GetScopes(), folderId,
+		time.Duration(in.GetExpiresIn())*time.Second)
+	if err != nil {
+		for _, invalid := range []error{
+			apiTokenService.ErrInvalidName,
+			apiTokenService.ErrInvalidScopes,
+			apiTokenService.ErrInvalidTTL,
+			apiTokenService.ErrFolderScopeReadOnly,
+		} {
+			if errors.Is(err, invalid) {
+				return nil, status.Error(codes.InvalidArgument, invalid.Error())
+			}
+		}
+		if errors.Is(err, repositories.ErrFolderNotFound) {
+			return nil, status.Error(codes.NotFound, "folder not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to create api token")
+	}
+	return &authv1.CreateAPITokenResponse{ApiToken: apiTokenResponse(apiToken), Token: token}, nil
+}
+
+func (s *serverAPI) ListAPITokens(
+	ctx context.Context,
+	in *authv1.ListAPITokensRequest,
+) (*authv1.ListAPITokensResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.apiTokens.ListTokens(ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list api tokens")
+	}
+	response := &authv1.ListAPITokensResponse{}
+	for _, token := range tokens {
+		response.ApiTokens = append(response.ApiTokens, apiTokenResponse(token))
+	}
+	return response, nil
+}
+
+func (s *serverAPI) RevokeAPIToken(
+	ctx context.Context,
+	in *authv1.RevokeAPITokenRequest,
+) (*authv1.RevokeAPITokenResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(in.GetId().GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+
+	if err := s.apiTokens.RevokeToken(ctx, userId, id); err != nil {
+		if errors.Is(err, repositories.ErrAPITokenNotFound) {
+			return nil, status.Error(codes.NotFound, "api token not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to revoke api token")
+	}
+	return &authv1.RevokeAPITokenResponse{}, nil
+}
+
+func apiTokenResponse(token domain.APIToken) *authv1.APIToken {
+	response := &authv1.APIToken{
+		Id:        &authv1.UUID{Value: token.ID.String()},
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		CreatedAt: token.CreatedAt.Unix(),
+		ExpiresAt: token.ExpiresAt.Unix(),
+	}
+	if token.FolderId != nil {
+		response.FolderId = &authv1.UUID{Value: token.FolderId.String()}
+	}
+	if token.LastUsedAt != nil {
+		response.LastUsedAt = token.LastUsedAt.Unix()
+	}
+	return response
+}
@@ -0,0 +1,125 @@
+package authgrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	appService "github.com/s0vunia/password-manager/internal/services/app"
+	authv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) RegisterApp(
+	ctx context.Context,
+	in *authv1.RegisterAppRequest,
+) (*authv1.RegisterAppResponse, error) {
+	app, secret, err := s.apps.RegisterApp(ctx, in.GetName(), in.GetRedirectUris())
+	if err != nil {
+		if errors.Is(err, appService.ErrInvalidAppName) {
+			return nil, status.Error(codes.InvalidArgument, "name must be 1 to 50 characters")
+		}
+		if errors.Is(err, appService.ErrInvalidRedirectURI) {
+			return nil, status.Error(codes.InvalidArgument, appService.ErrInvalidRedirectURI.Error())
+		}
+		return nil, appError(err, "failed to register app")
+	}
+	return &authv1.RegisterAppResponse{App: appResponse(app), Secret: secret}, nil
+}
+
+func (s *serverAPI) ListApps(
+	ctx context.Context,
+	in *authv1.ListAppsRequest,
+) (*authv1.ListAppsResponse, error) {
+	apps, err := s.apps.ListApps(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list apps")
+	}
+	response := &authv1.ListAppsResponse{}
+	for _, app := range apps {
+		response.Apps = append(response.Apps, appResponse(app))
+	}
+	return response, nil
+}
+
+func (s *serverAPI) RotateAppSecret(
+	ctx context.Context,
+	in *authv1.RotateAppSecretRequest,
+) (*authv1.RotateAppSecretResponse, error) {
+	if in.GetId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	secret, err := s.apps.RotateAppSecret(ctx, int64(in.GetId()))
+	if err != nil {
+		return nil, appError(err, "failed to rotate app secret")
+	}
+	return &authv1.RotateAppSecretResponse{Secret: secret}, nil
+}
+
+func (s *serverAPI) SetAppDisabled(
+	ctx context.Context,
+	in *authv1.SetAppDisabledRequest,
+) (*authv1.SetAppDisabledResponse, error) {
+	if in.GetId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.apps.SetAppDisabled(ctx, int64(in.GetId()), in.GetDisabled()); err != nil {
+		return nil, appError(err, "failed to change app state")
+	}
+	return &authv1.SetAppDisabledResponse{}, nil
+}
+
+func (s *serverAPI) DeleteApp(
+	ctx context.Context,
+	in *authv1.DeleteAppRequest,
+) (*authv1.DeleteAppResponse, error) {
+	if in.GetId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.apps.DeleteApp(ctx, int64(in.GetId())); err != nil {
+		return nil, appError(err, "failed to delete app")
+	}
+	return &authv1.DeleteAppResponse{}, nil
+}
+
+func (s *serverAPI) SetAppRedirectURIs(
+	ctx context.Context,
+	in *authv1.SetAppRedirectURIsRequest,
+) (*authv1.SetAppRedirectURIsResponse, error) {
+	if in.GetId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.apps.SetRedirectURIs(ctx, int64(in.GetId()), in.GetRedirectUris()); err != nil {
+		if errors.Is(err, appService.ErrInvalidRedirectURI) {
+			return nil, status.Error(codes.InvalidArgument, appService.ErrInvalidRedirectURI.Error())
+		}
+		return nil, appError(err, "failed to set redirect uris")
+	}
+	return &authv1.SetAppRedirectURIsResponse{}, nil
+}
+
+func appError(err error, msg string) error {
+	switch {
+	case errors.Is(err, repositories.ErrAppNotFound):
+		return status.Error(codes.NotFound, "app not found")
+	case errors.Is(err, repositories.ErrAppExists):
+		return status.Error(codes.AlreadyExists, "app already exists")
+	}
+	return status.Error(codes.Internal, msg)
+}
+
+func appResponse(app domain.App) *authv1.App {
+	return &authv1.App{
+		Id:              int32(app.ID),
+		Name:            app.Name,
+		Disabled:        app.Disabled,
+		CreatedAt:       app.CreatedAt.Unix(),
+		SecretRotatedAt: app.SecretRotatedAt.Unix(),
+		RedirectUris:    app.RedirectURIs,
+	}
+}
@@ -0,0 +1,33 @@
+package authgrpc
+
+import (
+	"context"
+	authv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) GetJWKS(
+	ctx context.Context,
+	in *authv1.GetJWKSRequest,
+) (*authv1.GetJWKSResponse, error) {
+	jwks, err := s.auth.JWKS(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get signing keys")
+	}
+
+	response := &authv1.GetJWKSResponse{}
+	for _, key := range jwks.Keys {
+		response.Keys = append(response.Keys, &authv1.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return response, nil
+}
@@ -2,16 +2,51 @@ package authgrpc
 
 import (
 	"context"
+	"errors"
 	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
 	"github.com/s0vunia/password-manager/internal/lib/jwt"
-	"github.com/s0vunia/password-manager/internal/repositories/app"
+	apiTokenService "github.com/s0vunia/password-manager/internal/services/apiToken"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"strings"
+	"time"
 )
 
-func JWTMiddleware(appRepo app.Repository) grpc.UnaryServerInterceptor {
+// RevocationChecker reports whether a token of the user issued at issuedAt was revoked
+// by its id or the id of its session.
+type RevocationChecker interface {
+	IsRevoked(ctx context.Context, userId uuid.UUID, ids []uuid.UUID, issuedAt time.Time) (bool, error)
+}
+
+type AdminChecker interface {
+	IsAdmin(ctx context.Context, userId uuid.UUID) (bool, error)
+}
+
+// APITokenAuthenticator looks up personal access tokens presented instead of a JWT.
+type APITokenAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (*domain.APIToken, error)
+}
+
+// APITokenRoute is the scope an API token needs to call an RPC. Tokens limited to a folder
+// can call only FolderScoped RPCs, which check items against the folder themselves.
+type APITokenRoute struct {
+	Scope        string
+	FolderScoped bool
+}
+
+// JWTMiddleware authenticates the user by an access token, or by an API token which
+// may call only RPCs of apiTokenRoutes its scopes allow.
+func JWTMiddleware(
+	appRepo jwt.AppProvider,
+	keys jwt.KeySource,
+	revocations RevocationChecker,
+	apiTokens APITokenAuthenticator,
+	apiTokenRoutes map[string]APITokenRoute,
+) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
@@ -25,22 +60,95 @@ func JWTMiddleware(appRepo app.Repository) grpc.UnaryServerInterceptor {
 
 		token := values[0]
 
+		if strings.HasPrefix(token, domain.APITokenPrefix) {
+			ctx, err := apiTokenContext(ctx, apiTokens, apiTokenRoutes[info.FullMethod], token)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+
 		// Проверка токена
 		// Здесь должен быть ваш код для проверки токена
 		// Например, вы можете использовать библиотеку для работы с JWT
 		// Если токен недействителен, верните ошибку
-		err, jwtToken := jwt.ProcessJWT(ctx, token, appRepo)
+		err, jwtToken := jwt.ProcessJWT(ctx, token, appRepo, keys)
 		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, "invalid token")
 		}
 		if claims, ok := jwtToken.Claims.(gojwt.MapClaims); ok {
+			// Tokens issued to OAuth clients only grant their scope.
+			if _, scoped := claims["scope"]; scoped {
+				return nil, status.Errorf(codes.Unauthenticated, "token is not valid for this API")
+			}
 			userId, ok := claims["uid"].(string)
 			if ok {
 				// Извлечение данных из токена
 				ctx = context.WithValue(ctx, "userID", userId)
 			}
+			sessionId, _ := claims["sid"].(string)
+			if sessionId != "" {
+				ctx = context.WithValue(ctx, "sessionID", sessionId)
+			}
+			tokenId, _ := claims["jti"].(string)
+			if tokenId != "" {
+				ctx = context.WithValue(ctx, "tokenID", tokenId)
+			}
+
+			uid, _ := uuid.Parse(userId)
+			sid, _ := uuid.Parse(sessionId)
+			jti, _ := uuid.Parse(tokenId)
+			revoked, err := revocations.IsRevoked(ctx, uid, []uuid.UUID{jti, sid}, jwt.IssuedAt(claims))
+			if err != nil {
+				return nil, status.Errorf(codes.Unavailable, "failed to check token")
+			}
+			if revoked {
+				return nil, status.Errorf(codes.Unauthenticated, "token is revoked")
+			}
 		}
 		// Если токен действителен, продолжайте обработку запроса
 		return handler(ctx, req)
 	}
 }
+
+// apiTokenContext authenticates the API token and checks it may call the RPC of route,
+// a zero route means API tokens can't call it.
+func apiTokenContext(ctx context.Context, apiTokens APITokenAuthenticator, route APITokenRoute, token string) (context.Context, error) {
+	apiToken, err := apiTokens.Authenticate(ctx, token)
+	if err != nil {
+		if errors.Is(err, apiTokenService.ErrInvalidToken) {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid token")
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to check token")
+	}
+	if route.Scope == "" || !apiToken.HasScope(route.Scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "api token doesn't grant access to this method")
+	}
+	if apiToken.FolderId != nil && !route.FolderScoped {
+		return nil, status.Errorf(codes.PermissionDenied, "api token is limited to a folder")
+	}
+
+	ctx = context.WithValue(ctx, "userID", apiToken.UserId.String())
+	if apiToken.FolderId != nil {
+		ctx = context.WithValue(ctx, "folderID", apiToken.FolderId.String())
+	}
+	return ctx, nil
+}
+
+// AdminMiddleware lets only admins through, it must run after JWTMiddleware.
+func AdminMiddleware(admins AdminChecker) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		userId, err := userIdFrom(ctx)
+		if err != nil {
+			return nil, err
+		}
+		admin, err := admins.IsAdmin(ctx, userId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permissions")
+		}
+		if !admin {
+			return nil, status.Errorf(codes.PermissionDenied, "admin role is required")
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,195 @@
+package authgrpc
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/jwt"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	apiTokenService "github.com/s0vunia/password-manager/internal/services/apiToken"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+type apps map[int64]domain.App
+
+func (a apps) App(ctx context.Context, appID int64) (domain.App, error) {
+	app, ok := a[appID]
+	if !ok {
+		return domain.App{}, repositories.ErrAppNotFound
+	}
+	return app, nil
+}
+
+type keyRing map[string]*jwt.SigningKey
+
+func (k keyRing) VerificationKey(ctx context.Context, kid string) (*jwt.SigningKey, error) {
+	key, ok := k[kid]
+	if !ok {
+		return nil, jwt.ErrUnknownKey
+	}
+	return key, nil
+}
+
+type revokedSessions map[uuid.UUID]bool
+
+func (r revokedSessions) IsRevoked(ctx context.Context, userId uuid.UUID, ids []uuid.UUID, issuedAt time.Time) (bool, error) {
+	for _, id := range ids {
+		if r[id] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type apiTokensByValue map[string]*domain.APIToken
+
+func (a apiTokensByValue) Authenticate(ctx context.Context, token string) (*domain.APIToken, error) {
+	apiToken, ok := a[token]
+	if !ok {
+		return nil, apiTokenService.ErrInvalidToken
+	}
+	return apiToken, nil
+}
+
+type admins map[uuid.UUID]bool
+
+func (a admins) IsAdmin(ctx context.Context, userId uuid.UUID) (bool, error) {
+	return a[userId], nil
+}
+
+const (
+	readRoute   = "/manager.Manager/GetItems"
+	folderRoute = "/manager.Manager/GetItem"
+	writeRoute  = "/manager.Manager/CreateLoginItem"
+	jwtRoute    = "/auth.Auth/ListSessions"
+)
+
+var testRoutes = map[string]APITokenRoute{
+	readRoute:   {Scope: domain.APITokenScopeRead},
+	folderRoute: {Scope: domain.APITokenScopeRead, FolderScoped: true},
+	writeRoute:  {Scope: domain.APITokenScopeWrite},
+}
+
+// authorize runs the middleware for the method and returns what the handler saw in its context.
+func authorize(interceptor grpc.UnaryServerInterceptor, method string, token string) (map[string]string, error) {
+	ctx := context.Background()
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", token))
+	}
+	seen := map[string]string{}
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		for _, key := range []string{"userID", "sessionID", "tokenID", "folderID"} {
+			if value, ok := ctx.Value(key).(string); ok {
+				seen[key] = value
+			}
+		}
+		return nil, nil
+	})
+	return seen, err
+}
+
+func TestJWTMiddleware(t *testing.T) {
+	key, err := jwt.GenerateKey(jwt.AlgorithmEdDSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app := domain.App{ID: 1, Name: "app"}
+	disabled := domain.App{ID: 2, Name: "disabled", Disabled: true}
+	user := domain.User{ID: uuid.New(), Login: "alice"}
+	revokedSession := uuid.New()
+	folderId := uuid.New()
+
+	accessToken := func(app domain.App, sessionId uuid.UUID) string {
+		token, err := jwt.NewToken(user, app, sessionId, time.Hour, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	oauthToken, err := jwt.NewOAuthToken(domain.AuthorizationCode{AppId: app.ID, UserId: user.ID, Login: user.Login, Scope: "openid"},
+		app, "https://auth.example.com", time.Hour, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apiToken := func(value string) string { return domain.APITokenPrefix + value }
+	apiTokens := apiTokensByValue{
+		apiToken("read"):   {UserId: user.ID, Scopes: []string{domain.APITokenScopeRead}},
+		apiToken("write"):  {UserId: user.ID, Scopes: []string{domain.APITokenScopeRead, domain.APITokenScopeWrite}},
+		apiToken("folder"): {UserId: user.ID, Scopes: []string{domain.APITokenScopeRead}, FolderId: &folderId},
+		apiToken("none"):   {UserId: user.ID},
+	}
+	interceptor := JWTMiddleware(apps{1: app, 2: disabled}, keyRing{key.ID: key}, revokedSessions{revokedSession: true}, apiTokens, testRoutes)
+
+	tests := []struct {
+		name       string
+		method     string
+		token      string
+		wantCode   codes.Code
+		wantFolder bool
+	}{
+		{name: "access token", method: jwtRoute, token: accessToken(app, uuid.New())},
+		{name: "access token of a revoked session", method: jwtRoute, token: accessToken(app, revokedSession), wantCode: codes.Unauthenticated},
+		{name: "access token of a disabled app", method: jwtRoute, token: accessToken(disabled, uuid.New()), wantCode: codes.Unauthenticated},
+		{name: "oauth access token", method: jwtRoute, token: oauthToken, wantCode: codes.Unauthenticated},
+		{name: "no token", method: jwtRoute, wantCode: codes.Unauthenticated},
+		{name: "malformed token", method: jwtRoute, token: "Bearer x", wantCode: codes.Unauthenticated},
+		{name: "api token with read scope", method: readRoute, token: apiToken("read")},
+		{name: "api token without write scope", method: writeRoute, token: apiToken("read"), wantCode: codes.PermissionDenied},
+		{name: "api token with write scope", method: writeRoute, token: apiToken("write")},
+		{name: "api token without scopes", method: readRoute, token: apiToken("none"), wantCode: codes.PermissionDenied},
+		{name: "api token on a route not open to api tokens", method: jwtRoute, token: apiToken("write"), wantCode: codes.PermissionDenied},
+		{name: "folder api token on a folder scoped route", method: folderRoute, token: apiToken("folder"), wantFolder: true},
+		{name: "folder api token on a vault wide route", method: readRoute, token: apiToken("folder"), wantCode: codes.PermissionDenied},
+		{name: "unknown api token", method: readRoute, token: apiToken("unknown"), wantCode: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen, err := authorize(interceptor, tt.method, tt.token)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if seen["userID"] != user.ID.String() {
+				t.Errorf("userID = %q, want %s", seen["userID"], user.ID)
+			}
+			if _, ok := seen["folderID"]; ok != tt.wantFolder {
+				t.Errorf("folderID = %q, want set %v", seen["folderID"], tt.wantFolder)
+			}
+		})
+	}
+}
+
+func TestAdminMiddleware(t *testing.T) {
+	admin, user := uuid.New(), uuid.New()
+	interceptor := AdminMiddleware(admins{admin: true})
+	tests := []struct {
+		name     string
+		userId   string
+		wantCode codes.Code
+	}{
+		{name: "admin", userId: admin.String()},
+		{name: "user", userId: user.String(), wantCode: codes.PermissionDenied},
+		{name: "unauthenticated", wantCode: codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.userId != "" {
+				ctx = context.WithValue(ctx, "userID", tt.userId)
+			}
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/auth.Auth/ListApps"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("code = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -3,9 +3,11 @@ package authgrpc
 import (
 	"context"
 	"errors"
-	authv1 "github.com/s0vunia/password-manager-protos/gen/go/auth"
 	"github.com/s0vunia/password-manager/internal/repositories"
+	apiTokenService "github.com/s0vunia/password-manager/internal/services/apiToken"
+	appService "github.com/s0vunia/password-manager/internal/services/app"
 	"github.com/s0vunia/password-manager/internal/services/auth"
+	authv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/auth"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,11 +15,13 @@ import (
 
 type serverAPI struct {
 	authv1.UnimplementedAuthServer
-	auth auth.IOAuth
+	auth      auth.IOAuth
+	apps      appService.IAppService
+	apiTokens apiTokenService.IAPITokenService
 }
 
-func Register(gRPCServer *grpc.Server, auth auth.IOAuth) {
-	authv1.RegisterAuthServer(gRPCServer, &serverAPI{auth: auth})
+func Register(gRPCServer *grpc.Server, auth auth.IOAuth, apps appService.IAppService, apiTokens apiTokenService.IAPITokenService) {
+	authv1.RegisterAuthServer(gRPCServer, &serverAPI{auth: auth, apps: apps, apiTokens: apiTokens})
 }
 
 func (s *serverAPI) Login(
@@ -36,15 +40,21 @@ func (s *serverAPI) Login(
 		return nil, status.Error(codes.InvalidArgument, "app_id is required")
 	}
 
-	token, err := s.auth.Login(ctx, in.GetLogin(), in.GetPassword(), int(in.GetAppId()))
+	result, err := s.auth.Login(ctx, in.GetLogin(), in.GetPassword(), int(in.GetAppId()), clientInfo(ctx, in.GetDeviceName()))
 	if err != nil {
 		if errors.Is(err, auth.ErrInvalidCredentials) {
 			return nil, status.Error(codes.InvalidArgument, "invalid login or password")
 		}
+		if errors.Is(err, repositories.ErrAppNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "app not found")
+		}
+		if errors.Is(err, auth.ErrAppDisabled) {
+			return nil, status.Error(codes.PermissionDenied, "app is disabled")
+		}
 
 		return nil, status.Error(codes.Internal, "failed to login")
 	}
-	return &authv1.LoginResponse{Token: token}, nil
+	return loginResponse(result), nil
 }
 
 func (s *serverAPI) Register(
@@ -64,9 +74,34 @@ func (s *serverAPI) Register(
 		if errors.Is(err, repositories.ErrUserExists) {
 			return nil, status.Error(codes.AlreadyExists, "user already exists")
 		}
+		if errors.Is(err, auth.ErrInvalidAuthHash) {
+			return nil, status.Error(codes.InvalidArgument, "password must be a client-derived auth hash")
+		}
 
 		return nil, status.Error(codes.Internal, "failed to register user")
 	}
 
 	return &authv1.RegisterResponse{UserId: &authv1.UUID{Value: uid.String()}}, nil
 }
+
+func (s *serverAPI) Prelogin(
+	ctx context.Context,
+	in *authv1.PreloginRequest,
+) (*authv1.PreloginResponse, error) {
+	if in.Login == "" {
+		return nil, status.Error(codes.InvalidArgument, "login is required")
+	}
+
+	params, err := s.auth.KDFParams(ctx, in.GetLogin())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get kdf params")
+	}
+
+	return &authv1.PreloginResponse{
+		Kdf:         params.Algorithm,
+		Salt:        params.Salt,
+		Memory:      params.Memory,
+		Iterations:  params.Iterations,
+		Parallelism: uint32(params.Parallelism),
+	}, nil
+}
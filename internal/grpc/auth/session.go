@@ -0,0 +1,171 @@
+package authgrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/services/auth"
+	authv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+)
+
+func (s *serverAPI) Refresh(
+	ctx context.Context,
+	in *authv1.RefreshRequest,
+) (*authv1.LoginResponse, error) {
+	if in.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	result, err := s.auth.Refresh(ctx, in.GetRefreshToken(), clientInfo(ctx, ""))
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidRefreshToken) {
+			return nil, status.Error(codes.Unauthenticated, "refresh token is invalid or expired")
+		}
+		if errors.Is(err, auth.ErrAppDisabled) {
+			return nil, status.Error(codes.PermissionDenied, "app is disabled")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to refresh session")
+	}
+	return loginResponse(result), nil
+}
+
+func (s *serverAPI) ListSessions(
+	ctx context.Context,
+	in *authv1.ListSessionsRequest,
+) (*authv1.ListSessionsResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.auth.ListSessions(ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list sessions")
+	}
+	current, _ := sessionIdFrom(ctx)
+	response := &authv1.ListSessionsResponse{}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, &authv1.Session{
+			Id:         &authv1.UUID{Value: session.ID.String()},
+			AppId:      int32(session.AppId),
+			DeviceName: session.DeviceName,
+			Ip:         session.IP,
+			CreatedAt:  session.CreatedAt.Unix(),
+			LastUsedAt: session.LastUsedAt.Unix(),
+			ExpiresAt:  session.ExpiresAt.Unix(),
+			Current:    session.ID == current,
+		})
+	}
+	return response, nil
+}
+
+func (s *serverAPI) RevokeSession(
+	ctx context.Context,
+	in *authv1.RevokeSessionRequest,
+) (*authv1.RevokeSessionResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionId, err := uuid.Parse(in.GetId().GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+
+	if err := s.auth.RevokeSession(ctx, userId, sessionId); err != nil {
+		if errors.Is(err, repositories.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to revoke session")
+	}
+	return &authv1.RevokeSessionResponse{}, nil
+}
+
+func (s *serverAPI) Logout(
+	ctx context.Context,
+	in *authv1.LogoutRequest,
+) (*authv1.LogoutResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionId, _ := sessionIdFrom(ctx)
+	ctxTokenId, _ := ctx.Value("tokenID").(string)
+	tokenId, _ := uuid.Parse(ctxTokenId)
+	if sessionId == uuid.Nil && tokenId == uuid.Nil {
+		return nil, status.Error(codes.Unauthenticated, "token can't be revoked")
+	}
+
+	if err := s.auth.Logout(ctx, userId, sessionId, tokenId); err != nil {
+		if errors.Is(err, repositories.ErrSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to logout")
+	}
+	return &authv1.LogoutResponse{}, nil
+}
+
+func (s *serverAPI) LogoutEverywhere(
+	ctx context.Context,
+	in *authv1.LogoutEverywhereRequest,
+) (*authv1.LogoutEverywhereResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.auth.RevokeAllSessions(ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke sessions")
+	}
+	return &authv1.LogoutEverywhereResponse{SessionsRevoked: int32(revoked)}, nil
+}
+
+func (s *serverAPI) RevokeUserTokens(
+	ctx context.Context,
+	in *authv1.RevokeUserTokensRequest,
+) (*authv1.RevokeUserTokensResponse, error) {
+	userId, err := uuid.Parse(in.GetUserId().GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "user_id is invalid")
+	}
+
+	revoked, err := s.auth.RevokeAllSessions(ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke user tokens")
+	}
+	return &authv1.RevokeUserTokensResponse{SessionsRevoked: int32(revoked)}, nil
+}
+
+// sessionIdFrom returns the session of the access token authenticated by JWTMiddleware.
+func sessionIdFrom(ctx context.Context) (uuid.UUID, error) {
+	ctxSessionId, _ := ctx.Value("sessionID").(string)
+	sessionId, err := uuid.Parse(ctxSessionId)
+	if err != nil {
+		return uuid.UUID{}, status.Error(codes.Unauthenticated, "token is not bound to a session")
+	}
+	return sessionId, nil
+}
+
+// clientInfo describes the caller for the list of sessions.
+func clientInfo(ctx context.Context, deviceName string) domain.ClientInfo {
+	client := domain.ClientInfo{DeviceName: deviceName}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		client.IP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(client.IP); err == nil {
+			client.IP = host
+		}
+	}
+	return client
+}
@@ -0,0 +1,149 @@
+package authgrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/services/auth"
+	authv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (s *serverAPI) LoginTwoFactor(
+	ctx context.Context,
+	in *authv1.LoginTwoFactorRequest,
+) (*authv1.LoginResponse, error) {
+	if in.ChallengeToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenge_token is required")
+	}
+
+	var assertion *domain.WebAuthnAssertion
+	if in.WebauthnAssertion != nil {
+		assertion = &domain.WebAuthnAssertion{
+			CredentialID:      in.WebauthnAssertion.GetCredentialId(),
+			ClientDataJSON:    in.WebauthnAssertion.GetClientDataJson(),
+			AuthenticatorData: in.WebauthnAssertion.GetAuthenticatorData(),
+			Signature:         in.WebauthnAssertion.GetSignature(),
+		}
+	} else if in.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code or webauthn_assertion is required")
+	}
+
+	result, err := s.auth.VerifyLogin(ctx, in.GetChallengeToken(), in.GetCode(), assertion, clientInfo(ctx, in.GetDeviceName()))
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidChallenge) {
+			return nil, status.Error(codes.Unauthenticated, "login challenge is invalid or expired")
+		}
+		if errors.Is(err, auth.ErrInvalidCode) {
+			return nil, status.Error(codes.InvalidArgument, "invalid code")
+		}
+		if errors.Is(err, auth.ErrAppDisabled) {
+			return nil, status.Error(codes.PermissionDenied, "app is disabled")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to login")
+	}
+	return loginResponse(result), nil
+}
+
+func (s *serverAPI) EnrollTOTP(
+	ctx context.Context,
+	in *authv1.EnrollTOTPRequest,
+) (*authv1.EnrollTOTPResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := s.auth.EnrollTOTP(ctx, userId)
+	if err != nil {
+		return nil, twoFactorError(err, "failed to enroll totp")
+	}
+	return &authv1.EnrollTOTPResponse{
+		Secret:     enrollment.Secret,
+		OtpauthUri: enrollment.URI,
+		QrPng:      enrollment.QRCode,
+	}, nil
+}
+
+func (s *serverAPI) ConfirmTOTP(
+	ctx context.Context,
+	in *authv1.ConfirmTOTPRequest,
+) (*authv1.ConfirmTOTPResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	recoveryCodes, err := s.auth.ConfirmTOTP(ctx, userId, in.GetCode())
+	if err != nil {
+		return nil, twoFactorError(err, "failed to confirm totp")
+	}
+	return &authv1.ConfirmTOTPResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *serverAPI) DisableTOTP(
+	ctx context.Context,
+	in *authv1.DisableTOTPRequest,
+) (*authv1.DisableTOTPResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	if err := s.auth.DisableTOTP(ctx, userId, in.GetCode()); err != nil {
+		return nil, twoFactorError(err, "failed to disable totp")
+	}
+	return &authv1.DisableTOTPResponse{}, nil
+}
+
+func loginResponse(result *domain.LoginResult) *authv1.LoginResponse {
+	response := &authv1.LoginResponse{
+		Token:              result.Token,
+		ChallengeToken:     result.ChallengeToken,
+		ChallengeExpiresIn: int64(result.ChallengeTTL / time.Second),
+		SecondFactors:      result.SecondFactors,
+		WebauthnOptions:    string(result.WebAuthnOptions),
+		RefreshToken:       result.RefreshToken,
+	}
+	if result.SessionId != uuid.Nil {
+		response.SessionId = &authv1.UUID{Value: result.SessionId.String()}
+	}
+	return response
+}
+
+func twoFactorError(err error, msg string) error {
+	switch {
+	case errors.Is(err, auth.ErrInvalidCode):
+		return status.Error(codes.InvalidArgument, "invalid code")
+	case errors.Is(err, repositories.ErrTOTPEnabled):
+		return status.Error(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	case errors.Is(err, repositories.ErrTOTPNotEnrolled):
+		return status.Error(codes.FailedPrecondition, "two-factor authentication is not enrolled")
+	case errors.Is(err, repositories.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	}
+	return status.Error(codes.Internal, msg)
+}
+
+// userIdFrom returns the user authenticated by JWTMiddleware.
+func userIdFrom(ctx context.Context) (uuid.UUID, error) {
+	ctxUserId, _ := ctx.Value("userID").(string)
+	userId, err := uuid.Parse(ctxUserId)
+	if err != nil {
+		return uuid.UUID{}, status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+	return userId, nil
+}
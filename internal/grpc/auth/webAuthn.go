@@ -0,0 +1,131 @@
+package authgrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/webauthn"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/services/auth"
+	authv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) BeginWebAuthnRegistration(
+	ctx context.Context,
+	in *authv1.BeginWebAuthnRegistrationRequest,
+) (*authv1.BeginWebAuthnRegistrationResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := s.auth.BeginWebAuthnRegistration(ctx, userId)
+	if err != nil {
+		return nil, webAuthnError(err, "failed to begin webauthn registration")
+	}
+	return &authv1.BeginWebAuthnRegistrationResponse{Options: string(options)}, nil
+}
+
+func (s *serverAPI) FinishWebAuthnRegistration(
+	ctx context.Context,
+	in *authv1.FinishWebAuthnRegistrationRequest,
+) (*authv1.FinishWebAuthnRegistrationResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.ClientDataJson) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "client_data_json is required")
+	}
+
+	if len(in.AttestationObject) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "attestation_object is required")
+	}
+
+	credential, recoveryCodes, err := s.auth.FinishWebAuthnRegistration(ctx, userId, in.GetName(),
+		in.GetClientDataJson(), in.GetAttestationObject())
+	if err != nil {
+		return nil, webAuthnError(err, "failed to finish webauthn registration")
+	}
+	return &authv1.FinishWebAuthnRegistrationResponse{
+		Credential:    webAuthnCredential(credential),
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (s *serverAPI) ListWebAuthnCredentials(
+	ctx context.Context,
+	in *authv1.ListWebAuthnCredentialsRequest,
+) (*authv1.ListWebAuthnCredentialsResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := s.auth.ListWebAuthnCredentials(ctx, userId)
+	if err != nil {
+		return nil, webAuthnError(err, "failed to list webauthn credentials")
+	}
+	response := &authv1.ListWebAuthnCredentialsResponse{}
+	for i := range credentials {
+		response.Credentials = append(response.Credentials, webAuthnCredential(&credentials[i]))
+	}
+	return response, nil
+}
+
+func (s *serverAPI) DeleteWebAuthnCredential(
+	ctx context.Context,
+	in *authv1.DeleteWebAuthnCredentialRequest,
+) (*authv1.DeleteWebAuthnCredentialResponse, error) {
+	userId, err := userIdFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(in.GetId().GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+
+	if err := s.auth.DeleteWebAuthnCredential(ctx, userId, id); err != nil {
+		return nil, webAuthnError(err, "failed to delete webauthn credential")
+	}
+	return &authv1.DeleteWebAuthnCredentialResponse{}, nil
+}
+
+func webAuthnCredential(credential *domain.WebAuthnCredential) *authv1.WebAuthnCredential {
+	result := &authv1.WebAuthnCredential{
+		Id:           &authv1.UUID{Value: credential.ID.String()},
+		Name:         credential.Name,
+		CredentialId: credential.CredentialID,
+		CreatedAt:    credential.CreatedAt.Unix(),
+	}
+	if credential.LastUsedAt != nil {
+		result.LastUsedAt = credential.LastUsedAt.Unix()
+	}
+	return result
+}
+
+func webAuthnError(err error, msg string) error {
+	switch {
+	case errors.Is(err, auth.ErrWebAuthnNotConfigured):
+		return status.Error(codes.FailedPrecondition, "security keys are not configured")
+	case errors.Is(err, auth.ErrInvalidChallenge):
+		return status.Error(codes.FailedPrecondition, "webauthn registration is not started or expired")
+	case errors.Is(err, auth.ErrInvalidCredentialName):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, webauthn.ErrInvalidResponse), errors.Is(err, webauthn.ErrUnsupported):
+		return status.Error(codes.InvalidArgument, "invalid webauthn response")
+	case errors.Is(err, repositories.ErrWebAuthnCredentialExists):
+		return status.Error(codes.AlreadyExists, "security key is already registered")
+	case errors.Is(err, repositories.ErrWebAuthnCredentialNotFound):
+		return status.Error(codes.NotFound, "security key not found")
+	case errors.Is(err, repositories.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	}
+	return status.Error(codes.Internal, msg)
+}
@@ -0,0 +1,214 @@
+package managergrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/services/manager/apiKeyItem"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (s serverApi) CreateAPIKeyItem(ctx context.Context, request *mngv1.CreateAPIKeyItemRequest) (*mngv1.CreateAPIKeyItemResponse, error) {
+	if request.Item == nil || request.Item.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "item.name is required")
+	}
+	if request.Item.FolderId == nil {
+		return nil, status.Error(codes.InvalidArgument, "item.folder_id is required")
+	}
+	folderId, err := uuid.Parse(request.Item.FolderId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "item.folder_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.ApiKey == nil || request.ApiKey.Secret == "" {
+		return nil, status.Error(codes.InvalidArgument, "api_key.secret is required")
+	}
+
+	item := RequestToAPIKeyItemModel(request.ApiKey)
+	item.Item = domain.Item{
+		Type:       domain.ItemTypeAPIKey,
+		Name:       request.Item.Name,
+		FolderId:   folderId,
+		UserId:     userId,
+		IsFavorite: request.Item.IsFavorite,
+	}
+	id, err := s.apiKeyItemService.CreateAPIKeyItem(ctx, item)
+	if err != nil {
+		if st := apiKeyValidationStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "failed to create API key item")
+	}
+	return &mngv1.CreateAPIKeyItemResponse{
+		Item: &mngv1.CreateItemResponse{
+			Id: &mngv1.UUID{Value: id.String()},
+		},
+	}, nil
+}
+
+func RequestToAPIKeyItemModel(key *mngv1.APIKeyDetails) domain.APIKeyItem {
+	item := domain.APIKeyItem{
+		KeyId:   key.KeyId,
+		Secret:  key.Secret,
+		Service: key.Service,
+	}
+	if key.ExpiresAt != 0 {
+		expiresAt := time.Unix(key.ExpiresAt, 0).UTC()
+		item.ExpiresAt = &expiresAt
+	}
+	return item
+}
+
+func (s serverApi) GetAPIKeyItem(ctx context.Context, request *mngv1.GetAPIKeyItemRequest) (*mngv1.GetAPIKeyItemResponse, error) {
+	if request.Item == nil || request.Item.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Item.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.apiKeyItemService.GetAPIKeyItem(ctx, id, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "API key item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get API key item")
+	}
+	if err := checkFolderScope(ctx, item.FolderId); err != nil {
+		return nil, err
+	}
+	return s.GetAPIKeyItemModelToResponse(*item), nil
+}
+
+func (s serverApi) GetAPIKeyItemModelToResponse(model domain.APIKeyItem) *mngv1.GetAPIKeyItemResponse {
+	details := &mngv1.APIKeyDetails{
+		KeyId:   model.KeyId,
+		Secret:  model.Secret,
+		Service: model.Service,
+		Expired: model.Expired(time.Now()),
+	}
+	if model.ExpiresAt != nil {
+		details.ExpiresAt = model.ExpiresAt.Unix()
+	}
+	return &mngv1.GetAPIKeyItemResponse{
+		Id:     &mngv1.UUID{Value: model.ID.String()},
+		Item:   s.GetItemModelToResponse(model.Item),
+		ApiKey: details,
+	}
+}
+
+func (s serverApi) GetAPIKeyItems(ctx context.Context, request *mngv1.GetAPIKeyItemsRequest) (*mngv1.GetAPIKeyItemsResponse, error) {
+	var requestUserId *mngv1.UUID
+	if request.Items != nil {
+		requestUserId = request.Items.UserId
+	}
+	userId, err := userIdFrom(ctx, requestUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.apiKeyItemService.GetAPIKeyItems(ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get API key items")
+	}
+	var listOfItems []*mngv1.GetAPIKeyItemResponse
+	for _, item := range items {
+		listOfItems = append(listOfItems, s.GetAPIKeyItemModelToResponse(*item))
+	}
+	return &mngv1.GetAPIKeyItemsResponse{ListOfItems: listOfItems}, nil
+}
+
+func (s serverApi) UpdateAPIKeyItem(ctx context.Context, request *mngv1.UpdateAPIKeyItemRequest) (*mngv1.UpdateAPIKeyItemResponse, error) {
+	if request.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	if request.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if request.FolderId == nil {
+		return nil, status.Error(codes.InvalidArgument, "folder_id is required")
+	}
+	folderId, err := uuid.Parse(request.FolderId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "folder_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.ApiKey == nil || request.ApiKey.Secret == "" {
+		return nil, status.Error(codes.InvalidArgument, "api_key.secret is required")
+	}
+
+	item := RequestToAPIKeyItemModel(request.ApiKey)
+	item.ID = id
+	item.Item = domain.Item{
+		Type:       domain.ItemTypeAPIKey,
+		Name:       request.Name,
+		FolderId:   folderId,
+		UserId:     userId,
+		IsFavorite: request.IsFavorite,
+	}
+	err = s.apiKeyItemService.UpdateAPIKeyItem(ctx, item)
+	if err != nil {
+		if st := apiKeyValidationStatus(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "API key item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to update API key item")
+	}
+	return &mngv1.UpdateAPIKeyItemResponse{}, nil
+}
+
+func (s serverApi) DeleteAPIKeyItem(ctx context.Context, request *mngv1.DeleteAPIKeyItemRequest) (*mngv1.DeleteAPIKeyItemResponse, error) {
+	if request.ItemId == nil {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+	itemId, err := uuid.Parse(request.ItemId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "item id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.apiKeyItemService.DeleteAPIKeyItem(ctx, userId, itemId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "API key item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to delete API key item")
+	}
+	return &mngv1.DeleteAPIKeyItemResponse{}, nil
+}
+
+func apiKeyValidationStatus(err error) error {
+	for _, validationErr := range []error{
+		apiKeyItem.ErrEmptySecret,
+	} {
+		if errors.Is(err, validationErr) {
+			return status.Error(codes.InvalidArgument, validationErr.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package managergrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/s0vunia/password-manager/internal/services/manager/breach"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s serverApi) CheckBreaches(ctx context.Context, request *mngv1.CheckBreachesRequest) (*mngv1.CheckBreachesResponse, error) {
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.breachService.CheckBreaches(ctx, userId)
+	if err != nil {
+		switch {
+		case errors.Is(err, breach.ErrCorpusUnavailable):
+			return nil, status.Error(codes.Unavailable, "breach check is not configured")
+		case errors.Is(err, breach.ErrZeroKnowledge):
+			return nil, status.Error(codes.FailedPrecondition, "vault is encrypted on the client, check breaches there")
+		}
+		return nil, status.Error(codes.Internal, "failed to check breaches")
+	}
+
+	response := &mngv1.CheckBreachesResponse{Checked: int32(report.Checked)}
+	for _, finding := range report.Breached {
+		response.Breached = append(response.Breached, &mngv1.BreachedItem{
+			ItemId:      &mngv1.UUID{Value: finding.ItemId.String()},
+			LoginItemId: &mngv1.UUID{Value: finding.LoginItemId.String()},
+			Name:        finding.Name,
+			Count:       finding.Count,
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,213 @@
+package managergrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/services/manager/cardItem"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s serverApi) CreateCardItem(ctx context.Context, request *mngv1.CreateCardItemRequest) (*mngv1.CreateCardItemResponse, error) {
+	if request.Item == nil || request.Item.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "item.name is required")
+	}
+	if request.Item.FolderId == nil {
+		return nil, status.Error(codes.InvalidArgument, "item.folder_id is required")
+	}
+	folderId, err := uuid.Parse(request.Item.FolderId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "item.folder_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.Card == nil || request.Card.Number == "" {
+		return nil, status.Error(codes.InvalidArgument, "card.number is required")
+	}
+
+	item := RequestToCardItemModel(request.Card)
+	item.Item = domain.Item{
+		Type:       domain.ItemTypeCard,
+		Name:       request.Item.Name,
+		FolderId:   folderId,
+		UserId:     userId,
+		IsFavorite: request.Item.IsFavorite,
+	}
+	id, err := s.cardItemService.CreateCardItem(ctx, item)
+	if err != nil {
+		if st := cardValidationStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "failed to create card item")
+	}
+	return &mngv1.CreateCardItemResponse{
+		Item: &mngv1.CreateItemResponse{
+			Id: &mngv1.UUID{Value: id.String()},
+		},
+	}, nil
+}
+
+func RequestToCardItemModel(card *mngv1.CardDetails) domain.CardItem {
+	return domain.CardItem{
+		Cardholder:  card.Cardholder,
+		Number:      card.Number,
+		ExpiryMonth: int(card.ExpiryMonth),
+		ExpiryYear:  int(card.ExpiryYear),
+		CVV:         card.Cvv,
+		Brand:       card.Brand,
+	}
+}
+
+func (s serverApi) GetCardItem(ctx context.Context, request *mngv1.GetCardItemRequest) (*mngv1.GetCardItemResponse, error) {
+	if request.Item == nil || request.Item.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Item.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.cardItemService.GetCardItem(ctx, id, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "card item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get card item")
+	}
+	if err := checkFolderScope(ctx, item.FolderId); err != nil {
+		return nil, err
+	}
+	return s.GetCardItemModelToResponse(*item), nil
+}
+
+func (s serverApi) GetCardItemModelToResponse(model domain.CardItem) *mngv1.GetCardItemResponse {
+	return &mngv1.GetCardItemResponse{
+		Id:   &mngv1.UUID{Value: model.ID.String()},
+		Item: s.GetItemModelToResponse(model.Item),
+		Card: &mngv1.CardDetails{
+			Cardholder:  model.Cardholder,
+			Number:      model.Number,
+			ExpiryMonth: int32(model.ExpiryMonth),
+			ExpiryYear:  int32(model.ExpiryYear),
+			Cvv:         model.CVV,
+			Brand:       model.Brand,
+		},
+	}
+}
+
+func (s serverApi) GetCardItems(ctx context.Context, request *mngv1.GetCardItemsRequest) (*mngv1.GetCardItemsResponse, error) {
+	var requestUserId *mngv1.UUID
+	if request.Items != nil {
+		requestUserId = request.Items.UserId
+	}
+	userId, err := userIdFrom(ctx, requestUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.cardItemService.GetCardItems(ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get card items")
+	}
+	var listOfItems []*mngv1.GetCardItemResponse
+	for _, item := range items {
+		listOfItems = append(listOfItems, s.GetCardItemModelToResponse(*item))
+	}
+	return &mngv1.GetCardItemsResponse{ListOfItems: listOfItems}, nil
+}
+
+func (s serverApi) UpdateCardItem(ctx context.Context, request *mngv1.UpdateCardItemRequest) (*mngv1.UpdateCardItemResponse, error) {
+	if request.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	if request.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if request.FolderId == nil {
+		return nil, status.Error(codes.InvalidArgument, "folder_id is required")
+	}
+	folderId, err := uuid.Parse(request.FolderId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "folder_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.Revision <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "revision is required")
+	}
+	if request.Card == nil || request.Card.Number == "" {
+		return nil, status.Error(codes.InvalidArgument, "card.number is required")
+	}
+
+	item := RequestToCardItemModel(request.Card)
+	item.ID = id
+	item.Item = domain.Item{
+		Type:       domain.ItemTypeCard,
+		Name:       request.Name,
+		FolderId:   folderId,
+		UserId:     userId,
+		IsFavorite: request.IsFavorite,
+		Revision:   request.Revision,
+	}
+	revision, err := s.cardItemService.UpdateCardItem(ctx, item)
+	if err != nil {
+		if st := cardValidationStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, updateStatus(err, "card item not found", "failed to update card item")
+	}
+	return &mngv1.UpdateCardItemResponse{Revision: revision}, nil
+}
+
+func (s serverApi) DeleteCardItem(ctx context.Context, request *mngv1.DeleteCardItemRequest) (*mngv1.DeleteCardItemResponse, error) {
+	if request.ItemId == nil {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+	itemId, err := uuid.Parse(request.ItemId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "item id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cardItemService.DeleteCardItem(ctx, userId, itemId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "card item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to delete card item")
+	}
+	return &mngv1.DeleteCardItemResponse{}, nil
+}
+
+func cardValidationStatus(err error) error {
+	for _, validationErr := range []error{
+		cardItem.ErrInvalidCardNumber,
+		cardItem.ErrInvalidExpiry,
+		cardItem.ErrCardExpired,
+		cardItem.ErrInvalidCVV,
+	} {
+		if errors.Is(err, validationErr) {
+			return status.Error(codes.InvalidArgument, validationErr.Error())
+		}
+	}
+	return nil
+}
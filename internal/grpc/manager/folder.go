@@ -0,0 +1,201 @@
+package managergrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/services/manager/folder"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxFolderNameLength = 50
+
+func (s serverApi) CreateFolder(ctx context.Context, request *mngv1.CreateFolderRequest) (*mngv1.CreateFolderResponse, error) {
+	if err := validateFolderName(request.Name); err != nil {
+		return nil, err
+	}
+	parentId, err := parentIdFrom(request.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.folderService.CreateFolder(ctx, domain.Folder{UserId: userId, Name: request.Name, ParentId: parentId})
+	if err != nil {
+		return nil, folderStatus(err, "failed to create folder")
+	}
+	return &mngv1.CreateFolderResponse{Id: &mngv1.UUID{Value: id.String()}}, nil
+}
+
+func (s serverApi) GetFolders(ctx context.Context, request *mngv1.GetFoldersRequest) (*mngv1.GetFoldersResponse, error) {
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	folders, err := s.folderService.GetFolders(ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get folders")
+	}
+	var listOfFolders []*mngv1.Folder
+	for _, folder := range folders {
+		listOfFolders = append(listOfFolders, FolderModelToResponse(*folder))
+	}
+	return &mngv1.GetFoldersResponse{ListOfFolders: listOfFolders}, nil
+}
+
+func (s serverApi) GetFolderByPath(ctx context.Context, request *mngv1.GetFolderByPathRequest) (*mngv1.GetFolderByPathResponse, error) {
+	if request.Path == "" {
+		return nil, status.Error(codes.InvalidArgument, "path is required")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := s.folderService.GetFolderByPath(ctx, userId, request.Path)
+	if err != nil {
+		return nil, folderStatus(err, "failed to get folder")
+	}
+	return &mngv1.GetFolderByPathResponse{Folder: FolderModelToResponse(*folder)}, nil
+}
+
+func FolderModelToResponse(model domain.Folder) *mngv1.Folder {
+	folder := &mngv1.Folder{
+		Id:     &mngv1.UUID{Value: model.ID.String()},
+		UserId: &mngv1.UUID{Value: model.UserId.String()},
+		Name:   model.Name,
+		Path:   model.Path,
+	}
+	if model.ParentId != uuid.Nil {
+		folder.ParentId = &mngv1.UUID{Value: model.ParentId.String()}
+	}
+	return folder
+}
+
+func (s serverApi) RenameFolder(ctx context.Context, request *mngv1.RenameFolderRequest) (*mngv1.RenameFolderResponse, error) {
+	id, err := folderIdFrom(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateFolderName(request.Name); err != nil {
+		return nil, err
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.folderService.RenameFolder(ctx, id, userId, request.Name)
+	if err != nil {
+		return nil, folderStatus(err, "failed to rename folder")
+	}
+	return &mngv1.RenameFolderResponse{}, nil
+}
+
+func (s serverApi) MoveFolder(ctx context.Context, request *mngv1.MoveFolderRequest) (*mngv1.MoveFolderResponse, error) {
+	id, err := folderIdFrom(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	parentId, err := parentIdFrom(request.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.folderService.MoveFolder(ctx, id, userId, parentId)
+	if err != nil {
+		return nil, folderStatus(err, "failed to move folder")
+	}
+	return &mngv1.MoveFolderResponse{}, nil
+}
+
+func (s serverApi) DeleteFolder(ctx context.Context, request *mngv1.DeleteFolderRequest) (*mngv1.DeleteFolderResponse, error) {
+	id, err := folderIdFrom(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	var mode domain.FolderDeleteMode
+	switch request.Mode {
+	case mngv1.FolderDeleteMode_MoveToRoot:
+		mode = domain.FolderDeleteMoveToRoot
+	case mngv1.FolderDeleteMode_Cascade:
+		mode = domain.FolderDeleteCascade
+	default:
+		return nil, status.Error(codes.InvalidArgument, "mode is invalid")
+	}
+
+	err = s.folderService.DeleteFolder(ctx, id, userId, mode)
+	if err != nil {
+		return nil, folderStatus(err, "failed to delete folder")
+	}
+	return &mngv1.DeleteFolderResponse{}, nil
+}
+
+func folderIdFrom(id *mngv1.UUID) (uuid.UUID, error) {
+	if id == nil {
+		return uuid.UUID{}, status.Error(codes.InvalidArgument, "id is required")
+	}
+	folderId, err := uuid.Parse(id.Value)
+	if err != nil {
+		return uuid.UUID{}, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	return folderId, nil
+}
+
+// parentIdFrom returns uuid.Nil, meaning the root of the vault, if parent isn't set.
+func parentIdFrom(id *mngv1.UUID) (uuid.UUID, error) {
+	if id == nil || id.Value == "" {
+		return uuid.Nil, nil
+	}
+	parentId, err := uuid.Parse(id.Value)
+	if err != nil {
+		return uuid.UUID{}, status.Error(codes.InvalidArgument, "parent_id is invalid")
+	}
+	return parentId, nil
+}
+
+func validateFolderName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return status.Error(codes.InvalidArgument, "name is required")
+	}
+	if strings.Contains(name, domain.FolderPathSeparator) {
+		return status.Errorf(codes.InvalidArgument, "name must not contain %q", domain.FolderPathSeparator)
+	}
+	if utf8.RuneCountInString(name) > maxFolderNameLength {
+		return status.Errorf(codes.InvalidArgument, "name must be at most %d characters", maxFolderNameLength)
+	}
+	return nil
+}
+
+func folderStatus(err error, internalMsg string) error {
+	switch {
+	case errors.Is(err, repositories.ErrFolderNotFound):
+		return status.Error(codes.NotFound, "folder not found")
+	case errors.Is(err, repositories.ErrFolderExists):
+		return status.Error(codes.AlreadyExists, "folder with this name already exists")
+	case errors.Is(err, repositories.ErrFolderCycle):
+		return status.Error(codes.InvalidArgument, repositories.ErrFolderCycle.Error())
+	case errors.Is(err, folder.ErrInvalidFolderPath):
+		return status.Error(codes.InvalidArgument, folder.ErrInvalidFolderPath.Error())
+	}
+	return status.Error(codes.Internal, internalMsg)
+}
@@ -0,0 +1,52 @@
+package managergrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/s0vunia/password-manager/internal/lib/passgen"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s serverApi) GeneratePassword(ctx context.Context, request *mngv1.GeneratePasswordRequest) (*mngv1.GeneratePasswordResponse, error) {
+	password, entropy, err := s.generate(ctx, request.Policy)
+	if err != nil {
+		return nil, err
+	}
+	return &mngv1.GeneratePasswordResponse{Password: password, EntropyBits: entropy}, nil
+}
+
+// generate produces a password or a passphrase described by policy, nil policy means the default password.
+func (s serverApi) generate(ctx context.Context, policy *mngv1.PasswordPolicy) (string, float64, error) {
+	var password string
+	var entropy float64
+	var err error
+	if policy.GetPassphrase() != nil {
+		password, entropy, err = s.generatorService.GeneratePassphrase(ctx, passgen.PassphrasePolicy{
+			Words:      int(policy.Passphrase.Words),
+			Separator:  policy.Passphrase.Separator,
+			Capitalize: policy.Passphrase.Capitalize,
+		})
+	} else {
+		password, entropy, err = s.generatorService.GeneratePassword(ctx, passgen.Policy{
+			Length:           int(policy.GetLength()),
+			Lower:            policy.GetLower(),
+			Upper:            policy.GetUpper(),
+			Digits:           policy.GetDigits(),
+			Symbols:          policy.GetSymbols(),
+			MinLower:         int(policy.GetMinLower()),
+			MinUpper:         int(policy.GetMinUpper()),
+			MinDigits:        int(policy.GetMinDigits()),
+			MinSymbols:       int(policy.GetMinSymbols()),
+			ExcludeAmbiguous: policy.GetExcludeAmbiguous(),
+		})
+	}
+	if err != nil {
+		if errors.Is(err, passgen.ErrInvalidPolicy) {
+			return "", 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return "", 0, status.Error(codes.Internal, "failed to generate password")
+	}
+	return password, entropy, nil
+}
@@ -0,0 +1,54 @@
+package managergrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/s0vunia/password-manager/internal/services/manager/health"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (s serverApi) GetVaultHealth(ctx context.Context, request *mngv1.GetVaultHealthRequest) (*mngv1.GetVaultHealthResponse, error) {
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.MaxAgeDays < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_age_days can't be negative")
+	}
+
+	report, err := s.healthService.VaultHealth(ctx, userId, time.Duration(request.MaxAgeDays)*24*time.Hour)
+	if err != nil {
+		if errors.Is(err, health.ErrZeroKnowledge) {
+			return nil, status.Error(codes.FailedPrecondition, "vault is encrypted on the client, check its health there")
+		}
+		return nil, status.Error(codes.Internal, "failed to get vault health")
+	}
+
+	response := &mngv1.GetVaultHealthResponse{
+		Score:  int32(report.Score),
+		Total:  int32(report.Total),
+		Weak:   int32(report.Weak),
+		Reused: int32(report.Reused),
+		Old:    int32(report.Old),
+	}
+	for _, item := range report.Items {
+		itemHealth := &mngv1.ItemHealth{
+			ItemId:             &mngv1.UUID{Value: item.ItemId.String()},
+			LoginItemId:        &mngv1.UUID{Value: item.LoginItemId.String()},
+			Name:               item.Name,
+			Score:              int32(item.Score),
+			EntropyBits:        item.EntropyBits,
+			Patterns:           item.Patterns,
+			ReuseGroup:         int32(item.ReuseGroup),
+			PasswordAgeSeconds: int64(item.PasswordAge.Seconds()),
+		}
+		for _, issue := range item.Issues {
+			itemHealth.Issues = append(itemHealth.Issues, mngv1.HealthIssue(issue))
+		}
+		response.Items = append(response.Items, itemHealth)
+	}
+	return response, nil
+}
@@ -0,0 +1,229 @@
+package managergrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/services/manager/identityItem"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s serverApi) CreateIdentityItem(ctx context.Context, request *mngv1.CreateIdentityItemRequest) (*mngv1.CreateIdentityItemResponse, error) {
+	if request.Item == nil || request.Item.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "item.name is required")
+	}
+	if request.Item.FolderId == nil {
+		return nil, status.Error(codes.InvalidArgument, "item.folder_id is required")
+	}
+	folderId, err := uuid.Parse(request.Item.FolderId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "item.folder_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.Identity == nil {
+		return nil, status.Error(codes.InvalidArgument, "identity is required")
+	}
+
+	item := RequestToIdentityItemModel(request.Identity)
+	item.Item = domain.Item{
+		Type:       domain.ItemTypeIdentity,
+		Name:       request.Item.Name,
+		FolderId:   folderId,
+		UserId:     userId,
+		IsFavorite: request.Item.IsFavorite,
+	}
+	id, err := s.identityItemService.CreateIdentityItem(ctx, item)
+	if err != nil {
+		if st := identityValidationStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "failed to create identity item")
+	}
+	return &mngv1.CreateIdentityItemResponse{
+		Item: &mngv1.CreateItemResponse{
+			Id: &mngv1.UUID{Value: id.String()},
+		},
+	}, nil
+}
+
+func RequestToIdentityItemModel(identity *mngv1.IdentityDetails) domain.IdentityItem {
+	return domain.IdentityItem{
+		Title:          identity.Title,
+		FirstName:      identity.FirstName,
+		MiddleName:     identity.MiddleName,
+		LastName:       identity.LastName,
+		Address1:       identity.Address1,
+		Address2:       identity.Address2,
+		City:           identity.City,
+		State:          identity.State,
+		PostalCode:     identity.PostalCode,
+		Country:        identity.Country,
+		Phone:          identity.Phone,
+		Email:          identity.Email,
+		PassportNumber: identity.PassportNumber,
+		NationalId:     identity.NationalId,
+		LicenseNumber:  identity.LicenseNumber,
+	}
+}
+
+func (s serverApi) GetIdentityItem(ctx context.Context, request *mngv1.GetIdentityItemRequest) (*mngv1.GetIdentityItemResponse, error) {
+	if request.Item == nil || request.Item.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Item.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.identityItemService.GetIdentityItem(ctx, id, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "identity item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get identity item")
+	}
+	if err := checkFolderScope(ctx, item.FolderId); err != nil {
+		return nil, err
+	}
+	return s.GetIdentityItemModelToResponse(*item), nil
+}
+
+func (s serverApi) GetIdentityItemModelToResponse(model domain.IdentityItem) *mngv1.GetIdentityItemResponse {
+	return &mngv1.GetIdentityItemResponse{
+		Id:   &mngv1.UUID{Value: model.ID.String()},
+		Item: s.GetItemModelToResponse(model.Item),
+		Identity: &mngv1.IdentityDetails{
+			Title:          model.Title,
+			FirstName:      model.FirstName,
+			MiddleName:     model.MiddleName,
+			LastName:       model.LastName,
+			Address1:       model.Address1,
+			Address2:       model.Address2,
+			City:           model.City,
+			State:          model.State,
+			PostalCode:     model.PostalCode,
+			Country:        model.Country,
+			Phone:          model.Phone,
+			Email:          model.Email,
+			PassportNumber: model.PassportNumber,
+			NationalId:     model.NationalId,
+			LicenseNumber:  model.LicenseNumber,
+		},
+	}
+}
+
+func (s serverApi) GetIdentityItems(ctx context.Context, request *mngv1.GetIdentityItemsRequest) (*mngv1.GetIdentityItemsResponse, error) {
+	var requestUserId *mngv1.UUID
+	if request.Items != nil {
+		requestUserId = request.Items.UserId
+	}
+	userId, err := userIdFrom(ctx, requestUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.identityItemService.GetIdentityItems(ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get identity items")
+	}
+	var listOfItems []*mngv1.GetIdentityItemResponse
+	for _, item := range items {
+		listOfItems = append(listOfItems, s.GetIdentityItemModelToResponse(*item))
+	}
+	return &mngv1.GetIdentityItemsResponse{ListOfItems: listOfItems}, nil
+}
+
+func (s serverApi) UpdateIdentityItem(ctx context.Context, request *mngv1.UpdateIdentityItemRequest) (*mngv1.UpdateIdentityItemResponse, error) {
+	if request.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	if request.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if request.FolderId == nil {
+		return nil, status.Error(codes.InvalidArgument, "folder_id is required")
+	}
+	folderId, err := uuid.Parse(request.FolderId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "folder_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.Identity == nil {
+		return nil, status.Error(codes.InvalidArgument, "identity is required")
+	}
+
+	item := RequestToIdentityItemModel(request.Identity)
+	item.ID = id
+	item.Item = domain.Item{
+		Type:       domain.ItemTypeIdentity,
+		Name:       request.Name,
+		FolderId:   folderId,
+		UserId:     userId,
+		IsFavorite: request.IsFavorite,
+	}
+	err = s.identityItemService.UpdateIdentityItem(ctx, item)
+	if err != nil {
+		if st := identityValidationStatus(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "identity item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to update identity item")
+	}
+	return &mngv1.UpdateIdentityItemResponse{}, nil
+}
+
+func (s serverApi) DeleteIdentityItem(ctx context.Context, request *mngv1.DeleteIdentityItemRequest) (*mngv1.DeleteIdentityItemResponse, error) {
+	if request.ItemId == nil {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+	itemId, err := uuid.Parse(request.ItemId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "item id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.identityItemService.DeleteIdentityItem(ctx, userId, itemId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "identity item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to delete identity item")
+	}
+	return &mngv1.DeleteIdentityItemResponse{}, nil
+}
+
+func identityValidationStatus(err error) error {
+	for _, validationErr := range []error{
+		identityItem.ErrEmptyIdentity,
+		identityItem.ErrInvalidEmail,
+		identityItem.ErrInvalidPhone,
+	} {
+		if errors.Is(err, validationErr) {
+			return status.Error(codes.InvalidArgument, validationErr.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package managergrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s serverApi) CreateNoteItem(ctx context.Context, request *mngv1.CreateNoteItemRequest) (*mngv1.CreateNoteItemResponse, error) {
+	if request.Item == nil || request.Item.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "item.name is required")
+	}
+	if request.Item.FolderId == nil {
+		return nil, status.Error(codes.InvalidArgument, "item.folder_id is required")
+	}
+	folderId, err := uuid.Parse(request.Item.FolderId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "item.folder_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.Note == "" {
+		return nil, status.Error(codes.InvalidArgument, "note is required")
+	}
+
+	id, err := s.noteItemService.CreateNoteItem(ctx, domain.NoteItem{
+		Item: domain.Item{
+			Type:       domain.ItemTypeNote,
+			Name:       request.Item.Name,
+			FolderId:   folderId,
+			UserId:     userId,
+			IsFavorite: request.Item.IsFavorite,
+		},
+		Note: request.Note,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create note item")
+	}
+	return &mngv1.CreateNoteItemResponse{
+		Item: &mngv1.CreateItemResponse{
+			Id: &mngv1.UUID{Value: id.String()},
+		},
+	}, nil
+}
+
+func (s serverApi) GetNoteItem(ctx context.Context, request *mngv1.GetNoteItemRequest) (*mngv1.GetNoteItemResponse, error) {
+	if request.Item == nil || request.Item.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Item.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.noteItemService.GetNoteItem(ctx, id, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "note item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get note item")
+	}
+	if err := checkFolderScope(ctx, item.FolderId); err != nil {
+		return nil, err
+	}
+	return s.GetNoteItemModelToResponse(*item), nil
+}
+
+func (s serverApi) GetNoteItemModelToResponse(model domain.NoteItem) *mngv1.GetNoteItemResponse {
+	return &mngv1.GetNoteItemResponse{
+		Id:   &mngv1.UUID{Value: model.ID.String()},
+		Item: s.GetItemModelToResponse(model.Item),
+		Note: model.Note,
+	}
+}
+
+func (s serverApi) GetNoteItems(ctx context.Context, request *mngv1.GetNoteItemsRequest) (*mngv1.GetNoteItemsResponse, error) {
+	var requestUserId *mngv1.UUID
+	if request.Items != nil {
+		requestUserId = request.Items.UserId
+	}
+	userId, err := userIdFrom(ctx, requestUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.noteItemService.GetNoteItems(ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get note items")
+	}
+	var listOfItems []*mngv1.GetNoteItemResponse
+	for _, item := range items {
+		listOfItems = append(listOfItems, s.GetNoteItemModelToResponse(*item))
+	}
+	return &mngv1.GetNoteItemsResponse{ListOfItems: listOfItems}, nil
+}
+
+func (s serverApi) UpdateNoteItem(ctx context.Context, request *mngv1.UpdateNoteItemRequest) (*mngv1.UpdateNoteItemResponse, error) {
+	if request.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	if request.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if request.FolderId == nil {
+		return nil, status.Error(codes.InvalidArgument, "folder_id is required")
+	}
+	folderId, err := uuid.Parse(request.FolderId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "folder_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.noteItemService.UpdateNoteItem(ctx, domain.NoteItem{
+		Item: domain.Item{
+			Type:       domain.ItemTypeNote,
+			Name:       request.Name,
+			FolderId:   folderId,
+			UserId:     userId,
+			IsFavorite: request.IsFavorite,
+		},
+		ID:   id,
+		Note: request.Note,
+	})
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "note item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to update note item")
+	}
+	return &mngv1.UpdateNoteItemResponse{}, nil
+}
+
+func (s serverApi) DeleteNoteItem(ctx context.Context, request *mngv1.DeleteNoteItemRequest) (*mngv1.DeleteNoteItemResponse, error) {
+	if request.ItemId == nil {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+	itemId, err := uuid.Parse(request.ItemId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "item id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.noteItemService.DeleteNoteItem(ctx, userId, itemId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "note item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to delete note item")
+	}
+	return &mngv1.DeleteNoteItemResponse{}, nil
+}
@@ -4,12 +4,21 @@ import (
 	"context"
 	"errors"
 	"github.com/google/uuid"
-	mngv1 "github.com/s0vunia/password-manager-protos/gen/go/manager"
 	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/totp"
 	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/services/manager/apiKeyItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/breach"
+	"github.com/s0vunia/password-manager/internal/services/manager/cardItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/folder"
+	"github.com/s0vunia/password-manager/internal/services/manager/generator"
+	"github.com/s0vunia/password-manager/internal/services/manager/health"
+	"github.com/s0vunia/password-manager/internal/services/manager/identityItem"
 	"github.com/s0vunia/password-manager/internal/services/manager/item"
 	"github.com/s0vunia/password-manager/internal/services/manager/loginItem"
-	log "github.com/sirupsen/logrus"
+	"github.com/s0vunia/password-manager/internal/services/manager/noteItem"
+	"github.com/s0vunia/password-manager/internal/services/manager/sshKeyItem"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,61 +26,158 @@ import (
 
 type serverApi struct {
 	mngv1.UnimplementedManagerServer
-	itemService      item.IItemService
-	loginItemService loginItem.ILoginItemService
+	itemService         item.IItemService
+	loginItemService    loginItem.ILoginItemService
+	noteItemService     noteItem.INoteItemService
+	cardItemService     cardItem.ICardItemService
+	identityItemService identityItem.IIdentityItemService
+	sshKeyItemService   sshKeyItem.ISSHKeyItemService
+	apiKeyItemService   apiKeyItem.IAPIKeyItemService
+	folderService       folder.IFolderService
+	generatorService    generator.IGeneratorService
+	healthService       health.IHealthService
+	breachService       breach.IBreachService
+	userProvider        UserProvider
 }
 
-func Register(gRPCServer *grpc.Server, itemService item.IItemService, loginItemService loginItem.ILoginItemService) {
-	mngv1.RegisterManagerServer(gRPCServer, &serverApi{itemService: itemService, loginItemService: loginItemService})
+// UserProvider tells whether the user's vault is encrypted on the client.
+type UserProvider interface {
+	IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error)
+}
+
+func Register(
+	gRPCServer *grpc.Server,
+	itemService item.IItemService,
+	loginItemService loginItem.ILoginItemService,
+	noteItemService noteItem.INoteItemService,
+	cardItemService cardItem.ICardItemService,
+	identityItemService identityItem.IIdentityItemService,
+	sshKeyItemService sshKeyItem.ISSHKeyItemService,
+	apiKeyItemService apiKeyItem.IAPIKeyItemService,
+	folderService folder.IFolderService,
+	generatorService generator.IGeneratorService,
+	healthService health.IHealthService,
+	breachService breach.IBreachService,
+	userProvider UserProvider,
+) {
+	mngv1.RegisterManagerServer(gRPCServer, &serverApi{
+		itemService:         itemService,
+		loginItemService:    loginItemService,
+		noteItemService:     noteItemService,
+		cardItemService:     cardItemService,
+		identityItemService: identityItemService,
+		sshKeyItemService:   sshKeyItemService,
+		apiKeyItemService:   apiKeyItemService,
+		folderService:       folderService,
+		generatorService:    generatorService,
+		healthService:       healthService,
+		breachService:       breachService,
+		userProvider:        userProvider,
+	})
+}
+
+// userIdFrom returns id of the authenticated user put to ctx by JWT middleware.
+// user_id in the request is optional and must match it.
+func userIdFrom(ctx context.Context, requestUserId *mngv1.UUID) (uuid.UUID, error) {
+	ctxUserId, _ := ctx.Value("userID").(string)
+	userId, err := uuid.Parse(ctxUserId)
+	if err != nil {
+		return uuid.UUID{}, status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+	if requestUserId != nil && requestUserId.Value != "" {
+		requested, err := uuid.Parse(requestUserId.Value)
+		if err != nil {
+			return uuid.UUID{}, status.Error(codes.InvalidArgument, "user_id is invalid")
+		}
+		if requested != userId {
+			return uuid.UUID{}, status.Error(codes.PermissionDenied, "access to other user's vault is denied")
+		}
+	}
+	return userId, nil
+}
+
+// checkFolderScope rejects items outside the folder the API token of the request is limited to.
+func checkFolderScope(ctx context.Context, folderId uuid.UUID) error {
+	scope, _ := ctx.Value("folderID").(string)
+	if scope != "" && scope != folderId.String() {
+		return status.Error(codes.PermissionDenied, "api token is limited to another folder")
+	}
+	return nil
 }
 
 func (s serverApi) CreateLoginItem(ctx context.Context, request *mngv1.CreateLoginItemRequest) (*mngv1.CreateLoginItemResponse, error) {
-	if request.Item.Name == "" {
+	if request.Item == nil || request.Item.Name == "" {
 		return nil, status.Error(codes.InvalidArgument, "item.name is required")
 	}
 	if request.Item.FolderId == nil {
 		return nil, status.Error(codes.InvalidArgument, "item.folder_id is required")
 	}
-	if request.Item.UserId == nil {
-		var err error
-		_, err = uuid.Parse(ctx.Value("userID").(string))
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "user_id is required")
-		}
-	} else {
-		_, _ = uuid.Parse(request.Item.UserId.Value)
+	folderId, err := uuid.Parse(request.Item.FolderId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "item.folder_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
 	}
 
 	if request.Login == "" {
 		return nil, status.Error(codes.InvalidArgument, "login is required")
 	}
+	var generated string
+	var entropy float64
+	if request.GeneratePassword != nil {
+		if request.EncryptPassword != "" {
+			return nil, status.Error(codes.InvalidArgument, "encrypt password and generate password are mutually exclusive")
+		}
+		// A generated password would be stored in plaintext where the client-encrypted one belongs.
+		zk, err := s.userProvider.IsZeroKnowledge(ctx, userId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to create login item")
+		}
+		if zk {
+			return nil, status.Error(codes.FailedPrecondition, "vault is encrypted on the client, generate the password with GeneratePassword and encrypt it there")
+		}
+		generated, entropy, err = s.generate(ctx, request.GeneratePassword)
+		if err != nil {
+			return nil, err
+		}
+		request.EncryptPassword = generated
+	}
 	if request.EncryptPassword == "" {
 		return nil, status.Error(codes.InvalidArgument, "encrypt password is required")
 	}
 
-	logItem := RequestToLoginItemModel(request, ctx)
+	logItem := RequestToLoginItemModel(request, folderId, userId)
 	id, err := s.loginItemService.CreateLoginItem(ctx, logItem)
 	if err != nil {
 		if errors.Is(err, repositories.ErrItemExists) {
 			return nil, status.Error(codes.NotFound, "item exists")
 		}
+		if errors.Is(err, totp.ErrInvalidURI) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to create login item")
 	}
-	return &mngv1.CreateLoginItemResponse{
+	response := &mngv1.CreateLoginItemResponse{
 		Item: &mngv1.CreateItemResponse{
 			Id: &mngv1.UUID{Value: id.String()},
 		},
-	}, nil
+		GeneratedPassword: generated,
+		EntropyBits:       entropy,
+	}
+	// The breach check only warns, the item is created anyway.
+	if generated == "" {
+		count, err := s.breachService.CheckNewPassword(ctx, request.EncryptPassword)
+		if err == nil && count > 0 {
+			response.BreachCount = count
+			response.Warning = "password was found in known data breaches, consider changing it"
+		}
+	}
+	return response, nil
 }
 
-func RequestToLoginItemModel(request *mngv1.CreateLoginItemRequest, ctx context.Context) domain.LoginItem {
-	folderId, _ := uuid.Parse(request.Item.FolderId.Value)
-	var userId uuid.UUID
-	if request.Item.UserId == nil {
-		userId, _ = uuid.Parse(ctx.Value("userID").(string))
-	} else {
-		userId, _ = uuid.Parse(request.Item.UserId.Value)
-	}
+func RequestToLoginItemModel(request *mngv1.CreateLoginItemRequest, folderId, userId uuid.UUID) domain.LoginItem {
 	return domain.LoginItem{
 		Item: domain.Item{
 			Type:       domain.ItemType(request.Item.Type),
@@ -82,6 +188,7 @@ func RequestToLoginItemModel(request *mngv1.CreateLoginItemRequest, ctx context.
 		},
 		Login:           request.Login,
 		EncryptPassword: request.EncryptPassword,
+		EncryptTOTP:     request.TotpUri,
 	}
 }
 
@@ -89,45 +196,50 @@ func (s serverApi) GetItem(ctx context.Context, request *mngv1.GetItemRequest) (
 	if request.Id == nil {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
-	var userId uuid.UUID
-	if request.UserId == nil {
-		var err error
-		userId, err = uuid.Parse(ctx.Value("userID").(string))
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "user_id is required")
-		}
-	} else {
-		userId, _ = uuid.Parse(request.UserId.Value)
+	id, err := uuid.Parse(request.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
 	}
-	id, _ := uuid.Parse(request.Id.Value)
 	item, err := s.itemService.GetItem(ctx, id, userId)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to get item")
 	}
+	if err := checkFolderScope(ctx, item.FolderId); err != nil {
+		return nil, err
+	}
 	return s.GetItemModelToResponse(*item), nil
 }
 
 func (s serverApi) GetItemModelToResponse(model domain.Item) *mngv1.GetItemResponse {
-	return &mngv1.GetItemResponse{
+	// Items in the root of the vault have no folder.
+	var folderId *mngv1.UUID
+	if model.FolderId != uuid.Nil {
+		folderId = &mngv1.UUID{Value: model.FolderId.String()}
+	}
+	response := &mngv1.GetItemResponse{
 		Id:         &mngv1.UUID{Value: model.ID.String()},
 		Name:       model.Name,
 		Type:       mngv1.ItemType(model.Type),
-		FolderId:   &mngv1.UUID{Value: model.FolderId.String()},
+		FolderId:   folderId,
 		UserId:     &mngv1.UUID{Value: model.UserId.String()},
 		IsFavorite: model.IsFavorite,
+		Summary:    model.Summary,
+		Revision:   model.Revision,
 	}
+	if model.DeletedAt != nil {
+		response.DeletedAt = model.DeletedAt.Unix()
+	}
+	return response
 }
 
 func (s serverApi) GetItems(ctx context.Context, request *mngv1.GetItemsRequest) (*mngv1.GetItemsResponse, error) {
-	var userId uuid.UUID
-	if request.UserId == nil {
-		var err error
-		userId, err = uuid.Parse(ctx.Value("userID").(string))
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "user_id is required")
-		}
-	} else {
-		userId, _ = uuid.Parse(request.UserId.Value)
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
 	}
 	items, err := s.itemService.GetItems(ctx, userId)
 	if err != nil {
@@ -141,26 +253,24 @@ func (s serverApi) GetItems(ctx context.Context, request *mngv1.GetItemsRequest)
 }
 
 func (s serverApi) GetLoginItem(ctx context.Context, request *mngv1.GetLoginItemRequest) (*mngv1.GetLoginItemResponse, error) {
-	log.Info(request)
-	if request.Item.Id == nil {
+	if request.Item == nil || request.Item.Id == nil {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
-	var userId uuid.UUID
-	if request.Item.UserId == nil {
-		var err error
-		userId, err = uuid.Parse(ctx.Value("userID").(string))
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "user_id is required")
-		}
-	} else {
-		userId, _ = uuid.Parse(request.Item.UserId.Value)
+	id, err := uuid.Parse(request.Item.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
 	}
-
-	id, _ := uuid.Parse(request.Item.Id.Value)
 	item, err := s.loginItemService.GetLoginItem(ctx, id, userId)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to get login item")
 	}
+	if err := checkFolderScope(ctx, item.FolderId); err != nil {
+		return nil, err
+	}
 	return s.GetLoginItemModelToResponse(*item), nil
 }
 
@@ -170,18 +280,13 @@ func (s serverApi) GetLoginItemModelToResponse(model domain.LoginItem) *mngv1.Ge
 		Item:            s.GetItemModelToResponse(model.Item),
 		Login:           model.Login,
 		EncryptPassword: model.EncryptPassword,
+		TotpUri:         model.EncryptTOTP,
 	}
 }
 func (s serverApi) GetLoginItems(ctx context.Context, request *mngv1.GetLoginItemsRequest) (*mngv1.GetLoginItemsResponse, error) {
-	var userId uuid.UUID
-	if request.Items.UserId == nil {
-		var err error
-		userId, err = uuid.Parse(ctx.Value("userID").(string))
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "user_id is required")
-		}
-	} else {
-		userId, _ = uuid.Parse(request.Items.UserId.Value)
+	userId, err := userIdFrom(ctx, request.Items.GetUserId())
+	if err != nil {
+		return nil, err
 	}
 	items, err := s.loginItemService.GetLoginItems(ctx, userId)
 	if err != nil {
@@ -194,45 +299,263 @@ func (s serverApi) GetLoginItems(ctx context.Context, request *mngv1.GetLoginIte
 	return &mngv1.GetLoginItemsResponse{ListOfItems: listOfItems}, nil
 }
 
-func (s serverApi) GetItemsByFolder(ctx context.Context, request *mngv1.GetItemsByFolderRequest) (*mngv1.GetItemsByFolderRequest, error) {
-	if request.FolderId == nil {
-		return nil, status.Error(codes.InvalidArgument, "folder_id is required")
+func (s serverApi) GetItemsByFolder(ctx context.Context, request *mngv1.GetItemsByFolderRequest) (*mngv1.GetItemsByFolderResponse, error) {
+	if request.FolderId == nil && request.Path == "" {
+		return nil, status.Error(codes.InvalidArgument, "folder_id or path is required")
 	}
-	var userId uuid.UUID
-	if request.UserId == nil {
-		var err error
-		userId, err = uuid.Parse(ctx.Value("userID").(string))
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	var folderId uuid.UUID
+	if request.FolderId != nil {
+		folderId, err = uuid.Parse(request.FolderId.Value)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "user_id is required")
+			return nil, status.Error(codes.InvalidArgument, "folder_id is invalid")
 		}
 	} else {
-		userId, _ = uuid.Parse(request.UserId.Value)
+		folder, err := s.folderService.GetFolderByPath(ctx, userId, request.Path)
+		if err != nil {
+			return nil, folderStatus(err, "failed to get items")
+		}
+		folderId = folder.ID
+	}
+	if err := checkFolderScope(ctx, folderId); err != nil {
+		return nil, err
+	}
+	if request.Recursive && ctx.Value("folderID") != nil {
+		return nil, status.Error(codes.PermissionDenied, "api token is limited to one folder")
+	}
+
+	items, err := s.itemService.GetItemsByFolder(ctx, folderId, userId, request.Recursive)
+	if err != nil {
+		return nil, folderStatus(err, "failed to get items")
 	}
-	log.Info(userId)
-	return nil, status.Error(codes.Canceled, "not supported")
+	var listOfItems []*mngv1.GetItemResponse
+	for _, item := range items {
+		listOfItems = append(listOfItems, s.GetItemModelToResponse(*item))
+	}
+	return &mngv1.GetItemsByFolderResponse{Items: &mngv1.GetItemsResponse{ListOfItems: listOfItems}}, nil
 }
 
 func (s serverApi) DeleteLoginItem(ctx context.Context, request *mngv1.DeleteLoginItemRequest) (*mngv1.DeleteLoginItemResponse, error) {
 	if request.ItemId == nil {
 		return nil, status.Error(codes.InvalidArgument, "item id is required")
 	}
-	var userId uuid.UUID
-	if request.UserId == nil {
-		var err error
-		userId, err = uuid.Parse(ctx.Value("userID").(string))
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "user_id is required")
-		}
-	} else {
-		userId, _ = uuid.Parse(request.UserId.Value)
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
 	}
 	itemId, err := uuid.Parse(request.ItemId.Value)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get login items")
+		return nil, status.Error(codes.InvalidArgument, "item id is invalid")
 	}
 	err = s.loginItemService.DeleteLoginItem(ctx, userId, itemId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get login items")
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "login item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to delete login item")
 	}
 	return &mngv1.DeleteLoginItemResponse{}, nil
 }
+
+func (s serverApi) UpdateItem(ctx context.Context, request *mngv1.UpdateItemRequest) (*mngv1.UpdateItemResponse, error) {
+	if request.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.Revision <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "revision is required")
+	}
+	if len(request.GetUpdateMask().GetPaths()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+
+	patch := domain.ItemPatch{ID: id, UserId: userId, Revision: request.Revision}
+	for _, path := range request.UpdateMask.Paths {
+		ok, err := applyItemPath(&patch, path, request.Name, request.FolderId, request.IsFavorite)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+	}
+
+	revision, err := s.itemService.UpdateItem(ctx, patch)
+	if err != nil {
+		return nil, updateStatus(err, "item not found", "failed to update item")
+	}
+	return &mngv1.UpdateItemResponse{Revision: revision}, nil
+}
+
+func (s serverApi) UpdateLoginItem(ctx context.Context, request *mngv1.UpdateLoginItemRequest) (*mngv1.UpdateLoginItemResponse, error) {
+	if request.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.Revision <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "revision is required")
+	}
+	if len(request.GetUpdateMask().GetPaths()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+
+	patch := domain.LoginItemPatch{
+		ItemPatch: domain.ItemPatch{UserId: userId, Revision: request.Revision},
+		ID:        id,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		ok, err := applyItemPath(&patch.ItemPatch, path, request.Name, request.FolderId, request.IsFavorite)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			continue
+		}
+		switch path {
+		case "login":
+			if request.Login == "" {
+				return nil, status.Error(codes.InvalidArgument, "login is required")
+			}
+			patch.Login = &request.Login
+		case "encrypt_password":
+			if request.EncryptPassword == "" {
+				return nil, status.Error(codes.InvalidArgument, "encrypt password is required")
+			}
+			patch.EncryptPassword = &request.EncryptPassword
+		case "totp_uri":
+			patch.EncryptTOTP = &request.TotpUri
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+	}
+
+	revision, err := s.loginItemService.UpdateLoginItem(ctx, patch)
+	if err != nil {
+		if errors.Is(err, totp.ErrInvalidURI) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, updateStatus(err, "login item not found", "failed to update login item")
+	}
+	return &mngv1.UpdateLoginItemResponse{Revision: revision}, nil
+}
+
+func (s serverApi) GetLoginItemHistory(ctx context.Context, request *mngv1.GetLoginItemHistoryRequest) (*mngv1.GetLoginItemHistoryResponse, error) {
+	if request.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.loginItemService.GetLoginItemHistory(ctx, id, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "login item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get login item history")
+	}
+	var listOfEntries []*mngv1.LoginItemHistoryEntry
+	for _, entry := range entries {
+		listOfEntries = append(listOfEntries, &mngv1.LoginItemHistoryEntry{
+			Id:              &mngv1.UUID{Value: entry.ID.String()},
+			Login:           entry.Login,
+			EncryptPassword: entry.EncryptPassword,
+			CreatedAt:       entry.CreatedAt.Unix(),
+		})
+	}
+	return &mngv1.GetLoginItemHistoryResponse{ListOfEntries: listOfEntries}, nil
+}
+
+func (s serverApi) RestoreLoginItemPassword(ctx context.Context, request *mngv1.RestoreLoginItemPasswordRequest) (*mngv1.RestoreLoginItemPasswordResponse, error) {
+	if request.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	if request.HistoryId == nil {
+		return nil, status.Error(codes.InvalidArgument, "history_id is required")
+	}
+	historyId, err := uuid.Parse(request.HistoryId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "history_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.Revision <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "revision is required")
+	}
+
+	revision, err := s.loginItemService.RestoreLoginItemPassword(ctx, userId, id, historyId, request.Revision)
+	if err != nil {
+		if errors.Is(err, repositories.ErrHistoryNotFound) {
+			return nil, status.Error(codes.NotFound, "history entry not found")
+		}
+		return nil, updateStatus(err, "login item not found", "failed to restore password")
+	}
+	return &mngv1.RestoreLoginItemPasswordResponse{Revision: revision}, nil
+}
+
+// applyItemPath sets the patch field named by update mask path.
+// It reports false if path isn't an attribute of the base item.
+func applyItemPath(patch *domain.ItemPatch, path, name string, folderId *mngv1.UUID, isFavorite bool) (bool, error) {
+	switch path {
+	case "name":
+		if name == "" {
+			return true, status.Error(codes.InvalidArgument, "name is required")
+		}
+		patch.Name = &name
+	case "folder_id":
+		if folderId == nil {
+			return true, status.Error(codes.InvalidArgument, "folder_id is required")
+		}
+		id, err := uuid.Parse(folderId.Value)
+		if err != nil {
+			return true, status.Error(codes.InvalidArgument, "folder_id is invalid")
+		}
+		patch.FolderId = &id
+	case "is_favorite":
+		patch.IsFavorite = &isFavorite
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+func updateStatus(err error, notFoundMsg, internalMsg string) error {
+	switch {
+	case errors.Is(err, repositories.ErrItemNotFound):
+		return status.Error(codes.NotFound, notFoundMsg)
+	case errors.Is(err, repositories.ErrFolderNotFound):
+		return status.Error(codes.InvalidArgument, "folder not found")
+	case errors.Is(err, repositories.ErrRevisionMismatch):
+		return status.Error(codes.Aborted, "item was modified concurrently, reload it and retry")
+	}
+	return status.Error(codes.Internal, internalMsg)
+}
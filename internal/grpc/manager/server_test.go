@@ -0,0 +1,189 @@
+package managergrpc
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/services/manager/breach"
+	"github.com/s0vunia/password-manager/internal/services/manager/generator"
+	"github.com/s0vunia/password-manager/internal/services/manager/item"
+	"github.com/s0vunia/password-manager/internal/services/manager/loginItem"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+// vaultItems records the user the vault was read for.
+type vaultItems struct {
+	item.IItemService
+	userId uuid.UUID
+}
+
+func (v *vaultItems) GetItems(ctx context.Context, userId uuid.UUID) ([]*domain.Item, error) {
+	v.userId = userId
+	return nil, nil
+}
+
+type vaultLoginItems struct {
+	loginItem.ILoginItemService
+	userId   uuid.UUID
+	password string
+}
+
+func (v *vaultLoginItems) CreateLoginItem(ctx context.Context, item domain.LoginItem) (uuid.UUID, error) {
+	v.userId = item.UserId
+	v.password = item.EncryptPassword
+	return uuid.New(), nil
+}
+
+func (v *vaultLoginItems) GetLoginItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.LoginItem, error) {
+	v.userId = userId
+	return &domain.LoginItem{Item: domain.Item{ID: itemId, UserId: userId}}, nil
+}
+
+func (v *vaultLoginItems) GetLoginItems(ctx context.Context, userId uuid.UUID) ([]*domain.LoginItem, error) {
+	v.userId = userId
+	return nil, nil
+}
+
+type zeroKnowledgeUsers map[uuid.UUID]bool
+
+func (z zeroKnowledgeUsers) IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error) {
+	return z[userId], nil
+}
+
+type noBreaches struct {
+	breach.IBreachService
+}
+
+func (noBreaches) CheckNewPassword(ctx context.Context, password string) (int64, error) {
+	return 0, nil
+}
+
+func TestHandlersUseAuthenticatedUser(t *testing.T) {
+	owner := uuid.New()
+	ctx := context.WithValue(context.Background(), "userID", owner.String())
+
+	calls := []struct {
+		name string
+		call func(s serverApi, requestUserId *mngv1.UUID) error
+	}{
+		{
+			name: "GetItems",
+			call: func(s serverApi, requestUserId *mngv1.UUID) error {
+				_, err := s.GetItems(ctx, &mngv1.GetItemsRequest{UserId: requestUserId})
+				return err
+			},
+		},
+		{
+			name: "GetLoginItem",
+			call: func(s serverApi, requestUserId *mngv1.UUID) error {
+				_, err := s.GetLoginItem(ctx, &mngv1.GetLoginItemRequest{Item: &mngv1.GetItemRequest{
+					Id:     &mngv1.UUID{Value: uuid.NewString()},
+					UserId: requestUserId,
+				}})
+				return err
+			},
+		},
+		{
+			name: "GetLoginItems",
+			call: func(s serverApi, requestUserId *mngv1.UUID) error {
+				_, err := s.GetLoginItems(ctx, &mngv1.GetLoginItemsRequest{Items: &mngv1.GetItemsRequest{UserId: requestUserId}})
+				return err
+			},
+		},
+		{
+			name: "CreateLoginItem",
+			call: func(s serverApi, requestUserId *mngv1.UUID) error {
+				_, err := s.CreateLoginItem(ctx, &mngv1.CreateLoginItemRequest{
+					Item: &mngv1.CreateItemRequest{
+						Name:     "mail",
+						FolderId: &mngv1.UUID{Value: uuid.NewString()},
+						UserId:   requestUserId,
+					},
+					Login:           "alice",
+					EncryptPassword: "client-encrypted blob",
+				})
+				return err
+			},
+		},
+	}
+	tests := []struct {
+		name          string
+		requestUserId *mngv1.UUID
+		wantCode      codes.Code
+	}{
+		{name: "user_id omitted", requestUserId: nil, wantCode: codes.OK},
+		{name: "own user_id", requestUserId: &mngv1.UUID{Value: owner.String()}, wantCode: codes.OK},
+		{name: "other user_id", requestUserId: &mngv1.UUID{Value: uuid.NewString()}, wantCode: codes.PermissionDenied},
+		{name: "invalid user_id", requestUserId: &mngv1.UUID{Value: "not-a-uuid"}, wantCode: codes.InvalidArgument},
+	}
+	for _, c := range calls {
+		for _, tt := range tests {
+			t.Run(c.name+"/"+tt.name, func(t *testing.T) {
+				items := &vaultItems{}
+				loginItems := &vaultLoginItems{}
+				s := serverApi{itemService: items, loginItemService: loginItems, breachService: noBreaches{}}
+
+				err := c.call(s, tt.requestUserId)
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("code = %v, want %v (err: %v)", status.Code(err), tt.wantCode, err)
+				}
+				if tt.wantCode != codes.OK {
+					if items.userId != uuid.Nil || loginItems.userId != uuid.Nil {
+						t.Fatal("service was called for a rejected request")
+					}
+					return
+				}
+				if items.userId != owner && loginItems.userId != owner {
+					t.Fatal("service was not called with the authenticated user")
+				}
+			})
+		}
+	}
+}
+
+func TestCreateLoginItemGeneratePassword(t *testing.T) {
+	user, zkUser := uuid.New(), uuid.New()
+	tests := []struct {
+		name     string
+		userId   uuid.UUID
+		wantCode codes.Code
+	}{
+		{name: "server-side encryption", userId: user, wantCode: codes.OK},
+		{name: "zero-knowledge vault", userId: zkUser, wantCode: codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loginItems := &vaultLoginItems{}
+			s := serverApi{
+				loginItemService: loginItems,
+				generatorService: generator.New(slog.New(slog.NewTextHandler(io.Discard, nil))),
+				breachService:    noBreaches{},
+				userProvider:     zeroKnowledgeUsers{zkUser: true},
+			}
+			ctx := context.WithValue(context.Background(), "userID", tt.userId.String())
+
+			response, err := s.CreateLoginItem(ctx, &mngv1.CreateLoginItemRequest{
+				Item:             &mngv1.CreateItemRequest{Name: "mail", FolderId: &mngv1.UUID{Value: uuid.NewString()}},
+				Login:            "alice",
+				GeneratePassword: &mngv1.PasswordPolicy{},
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err: %v)", status.Code(err), tt.wantCode, err)
+			}
+			if err != nil {
+				if loginItems.userId != uuid.Nil {
+					t.Error("login item was created")
+				}
+				return
+			}
+			if response.GeneratedPassword == "" || loginItems.password != response.GeneratedPassword {
+				t.Errorf("stored password %q, generated %q", loginItems.password, response.GeneratedPassword)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package managergrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/sshkey"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/services/manager/sshKeyItem"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s serverApi) CreateSSHKeyItem(ctx context.Context, request *mngv1.CreateSSHKeyItemRequest) (*mngv1.CreateSSHKeyItemResponse, error) {
+	if request.Item == nil || request.Item.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "item.name is required")
+	}
+	if request.Item.FolderId == nil {
+		return nil, status.Error(codes.InvalidArgument, "item.folder_id is required")
+	}
+	folderId, err := uuid.Parse(request.Item.FolderId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "item.folder_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.SshKey == nil || request.SshKey.PrivateKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "ssh_key.private_key is required")
+	}
+
+	item := RequestToSSHKeyItemModel(request.SshKey)
+	item.Item = domain.Item{
+		Type:       domain.ItemTypeSSHKey,
+		Name:       request.Item.Name,
+		FolderId:   folderId,
+		UserId:     userId,
+		IsFavorite: request.Item.IsFavorite,
+	}
+	id, err := s.sshKeyItemService.CreateSSHKeyItem(ctx, item)
+	if err != nil {
+		if st := sshKeyValidationStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, status.Error(codes.Internal, "failed to create SSH key item")
+	}
+	return &mngv1.CreateSSHKeyItemResponse{
+		Item: &mngv1.CreateItemResponse{
+			Id: &mngv1.UUID{Value: id.String()},
+		},
+	}, nil
+}
+
+func RequestToSSHKeyItemModel(key *mngv1.SSHKeyDetails) domain.SSHKeyItem {
+	return domain.SSHKeyItem{
+		PrivateKey: key.PrivateKey,
+		Passphrase: key.Passphrase,
+		PublicKey:  key.PublicKey,
+		Comment:    key.Comment,
+	}
+}
+
+func (s serverApi) GetSSHKeyItem(ctx context.Context, request *mngv1.GetSSHKeyItemRequest) (*mngv1.GetSSHKeyItemResponse, error) {
+	if request.Item == nil || request.Item.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Item.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.Item.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.sshKeyItemService.GetSSHKeyItem(ctx, id, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "SSH key item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get SSH key item")
+	}
+	if err := checkFolderScope(ctx, item.FolderId); err != nil {
+		return nil, err
+	}
+	return s.GetSSHKeyItemModelToResponse(*item), nil
+}
+
+func (s serverApi) GetSSHKeyItemModelToResponse(model domain.SSHKeyItem) *mngv1.GetSSHKeyItemResponse {
+	return &mngv1.GetSSHKeyItemResponse{
+		Id:   &mngv1.UUID{Value: model.ID.String()},
+		Item: s.GetItemModelToResponse(model.Item),
+		SshKey: &mngv1.SSHKeyDetails{
+			PrivateKey:  model.PrivateKey,
+			Passphrase:  model.Passphrase,
+			PublicKey:   model.PublicKey,
+			Comment:     model.Comment,
+			KeyType:     model.KeyType,
+			Fingerprint: model.Fingerprint,
+		},
+	}
+}
+
+func (s serverApi) GetSSHKeyItems(ctx context.Context, request *mngv1.GetSSHKeyItemsRequest) (*mngv1.GetSSHKeyItemsResponse, error) {
+	var requestUserId *mngv1.UUID
+	if request.Items != nil {
+		requestUserId = request.Items.UserId
+	}
+	userId, err := userIdFrom(ctx, requestUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.sshKeyItemService.GetSSHKeyItems(ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get SSH key items")
+	}
+	var listOfItems []*mngv1.GetSSHKeyItemResponse
+	for _, item := range items {
+		listOfItems = append(listOfItems, s.GetSSHKeyItemModelToResponse(*item))
+	}
+	return &mngv1.GetSSHKeyItemsResponse{ListOfItems: listOfItems}, nil
+}
+
+func (s serverApi) UpdateSSHKeyItem(ctx context.Context, request *mngv1.UpdateSSHKeyItemRequest) (*mngv1.UpdateSSHKeyItemResponse, error) {
+	if request.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	if request.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if request.FolderId == nil {
+		return nil, status.Error(codes.InvalidArgument, "folder_id is required")
+	}
+	folderId, err := uuid.Parse(request.FolderId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "folder_id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if request.SshKey == nil || request.SshKey.PrivateKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "ssh_key.private_key is required")
+	}
+
+	item := RequestToSSHKeyItemModel(request.SshKey)
+	item.ID = id
+	item.Item = domain.Item{
+		Type:       domain.ItemTypeSSHKey,
+		Name:       request.Name,
+		FolderId:   folderId,
+		UserId:     userId,
+		IsFavorite: request.IsFavorite,
+	}
+	err = s.sshKeyItemService.UpdateSSHKeyItem(ctx, item)
+	if err != nil {
+		if st := sshKeyValidationStatus(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "SSH key item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to update SSH key item")
+	}
+	return &mngv1.UpdateSSHKeyItemResponse{}, nil
+}
+
+func (s serverApi) DeleteSSHKeyItem(ctx context.Context, request *mngv1.DeleteSSHKeyItemRequest) (*mngv1.DeleteSSHKeyItemResponse, error) {
+	if request.ItemId == nil {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+	itemId, err := uuid.Parse(request.ItemId.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "item id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.sshKeyItemService.DeleteSSHKeyItem(ctx, userId, itemId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "SSH key item not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to delete SSH key item")
+	}
+	return &mngv1.DeleteSSHKeyItemResponse{}, nil
+}
+
+func sshKeyValidationStatus(err error) error {
+	for _, validationErr := range []error{
+		sshkey.ErrInvalidPrivateKey,
+		sshkey.ErrInvalidPublicKey,
+		sshkey.ErrPassphrase,
+		sshkey.ErrKeyMismatch,
+		sshKeyItem.ErrPublicKeyRequired,
+	} {
+		if errors.Is(err, validationErr) {
+			return status.Error(codes.InvalidArgument, validationErr.Error())
+		}
+	}
+	return nil
+}
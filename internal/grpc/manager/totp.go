@@ -0,0 +1,45 @@
+package managergrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/services/manager/loginItem"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (s serverApi) GetTOTPCode(ctx context.Context, request *mngv1.GetTOTPCodeRequest) (*mngv1.GetTOTPCodeResponse, error) {
+	if request.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(request.Id.Value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := s.loginItemService.GetTOTPCode(ctx, id, userId)
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrItemNotFound):
+			return nil, status.Error(codes.NotFound, "login item not found")
+		case errors.Is(err, loginItem.ErrNoTOTP):
+			return nil, status.Error(codes.FailedPrecondition, "login item has no totp secret")
+		case errors.Is(err, loginItem.ErrZeroKnowledge):
+			return nil, status.Error(codes.FailedPrecondition, "vault is encrypted on the client, generate the code there")
+		}
+		return nil, status.Error(codes.Internal, "failed to get totp code")
+	}
+	return &mngv1.GetTOTPCodeResponse{
+		Code:             code.Code,
+		SecondsRemaining: int32((code.Remaining + time.Second - 1) / time.Second),
+		Period:           int32(code.Period / time.Second),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package managergrpc
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	mngv1 "github.com/s0vunia/password-manager/pkg/protos/gen/go/manager"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s serverApi) ListTrash(ctx context.Context, request *mngv1.ListTrashRequest) (*mngv1.ListTrashResponse, error) {
+	userId, err := userIdFrom(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.itemService.ListTrash(ctx, userId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list trash")
+	}
+	var listOfItems []*mngv1.GetItemResponse
+	for _, item := range items {
+		listOfItems = append(listOfItems, s.GetItemModelToResponse(*item))
+	}
+	return &mngv1.ListTrashResponse{ListOfItems: listOfItems}, nil
+}
+
+func (s serverApi) RestoreItem(ctx context.Context, request *mngv1.RestoreItemRequest) (*mngv1.RestoreItemResponse, error) {
+	id, userId, err := trashItemIdFrom(ctx, request.Id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.itemService.RestoreItem(ctx, id, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "item not found in trash")
+		}
+		return nil, status.Error(codes.Internal, "failed to restore item")
+	}
+	return &mngv1.RestoreItemResponse{Revision: revision}, nil
+}
+
+func (s serverApi) PurgeItem(ctx context.Context, request *mngv1.PurgeItemRequest) (*mngv1.PurgeItemResponse, error) {
+	id, userId, err := trashItemIdFrom(ctx, request.Id, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.itemService.PurgeItem(ctx, id, userId); err != nil {
+		if errors.Is(err, repositories.ErrItemNotFound) {
+			return nil, status.Error(codes.NotFound, "item not found in trash")
+		}
+		return nil, status.Error(codes.Internal, "failed to purge item")
+	}
+	return &mngv1.PurgeItemResponse{}, nil
+}
+
+func trashItemIdFrom(ctx context.Context, requestId, requestUserId *mngv1.UUID) (uuid.UUID, uuid.UUID, error) {
+	if requestId == nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	id, err := uuid.Parse(requestId.Value)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "id is invalid")
+	}
+	userId, err := userIdFrom(ctx, requestUserId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return id, userId, nil
+}
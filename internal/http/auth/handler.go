@@ -0,0 +1,87 @@
+package authhttp
+
+import (
+	"encoding/json"
+	"github.com/s0vunia/password-manager/internal/services/auth"
+	"net/http"
+)
+
+type handler struct {
+	auth auth.IOAuth
+}
+
+func Register(mux *http.ServeMux, auth auth.IOAuth) {
+	h := &handler{auth: auth}
+	mux.HandleFunc("/auth/prelogin", h.Prelogin)
+	mux.HandleFunc("/.well-known/jwks.json", h.JWKS)
+	mux.HandleFunc("/.well-known/openid-configuration", h.OpenIDConfiguration)
+	mux.HandleFunc("/oauth/authorize", h.Authorize)
+	mux.HandleFunc("/oauth/token", h.Token)
+	mux.HandleFunc("/oauth/userinfo", h.UserInfo)
+}
+
+type preloginResponse struct {
+	Algorithm   string `json:"kdf"`
+	Salt        []byte `json:"salt"`
+	Memory      uint32 `json:"memory"`
+	Iterations  uint32 `json:"iterations"`
+	Parallelism uint8  `json:"parallelism"`
+}
+
+// Prelogin publishes KDF parameters of a login, see auth.Auth.KDFParams.
+// GET /auth/prelogin?login=<login>
+func (h *handler) Prelogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	login := r.URL.Query().Get("login")
+	if login == "" {
+		writeError(w, http.StatusBadRequest, "login is required")
+		return
+	}
+
+	params, err := h.auth.KDFParams(r.Context(), login)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to get kdf params")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, preloginResponse{
+		Algorithm:   params.Algorithm,
+		Salt:        params.Salt,
+		Memory:      params.Memory,
+		Iterations:  params.Iterations,
+		Parallelism: params.Parallelism,
+	})
+}
+
+// JWKS publishes public keys access tokens are verified with.
+// GET /.well-known/jwks.json
+func (h *handler) JWKS(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	jwks, err := h.auth.JWKS(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to get signing keys")
+		return
+	}
+
+	// Keys are published a day ahead by default, a short cache keeps verifiers in time.
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, jwks)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, map[string]string{"error": msg})
+}
@@ -0,0 +1,238 @@
+package authhttp
+
+import (
+	"errors"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/services/auth"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type openIDConfigurationResponse struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	IDToken     string `json:"id_token"`
+	Scope       string `json:"scope"`
+}
+
+type userInfoResponse struct {
+	Subject           string `json:"sub"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+}
+
+type oauthErrorResponse struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+// OpenIDConfiguration publishes the OpenID Connect discovery metadata.
+// GET /.well-known/openid-configuration
+func (h *handler) OpenIDConfiguration(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	config, err := h.auth.OpenIDConfiguration(r.Context())
+	if err != nil {
+		if errors.Is(err, auth.ErrOAuthDisabled) {
+			writeError(w, http.StatusNotFound, "oauth is disabled")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "failed to get openid configuration")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, openIDConfigurationResponse{
+		Issuer:                            config.Issuer,
+		AuthorizationEndpoint:             config.AuthorizationEndpoint,
+		TokenEndpoint:                     config.TokenEndpoint,
+		UserInfoEndpoint:                  config.UserInfoEndpoint,
+		JWKSURI:                           config.JWKSURI,
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{config.SigningAlgorithm},
+		ScopesSupported:                   config.Scopes,
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		ClaimsSupported:                   []string{"sub", "preferred_username"},
+	})
+}
+
+// Authorize issues an authorization code to the client and redirects the user agent back to it.
+// The user is authenticated by the access token of their session.
+// GET|POST /oauth/authorize?response_type=code&client_id=<id>&redirect_uri=<uri>&scope=openid
+// &state=<state>&nonce=<nonce>&code_challenge=<challenge>&code_challenge_method=S256
+// Authorization: Bearer <access token>
+func (h *handler) Authorize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	req := domain.AuthorizationRequest{
+		ResponseType:        r.FormValue("response_type"),
+		ClientId:            r.FormValue("client_id"),
+		RedirectURI:         r.FormValue("redirect_uri"),
+		Scope:               r.FormValue("scope"),
+		State:               r.FormValue("state"),
+		Nonce:               r.FormValue("nonce"),
+		CodeChallenge:       r.FormValue("code_challenge"),
+		CodeChallengeMethod: r.FormValue("code_challenge_method"),
+	}
+	code, err := h.auth.Authorize(r.Context(), bearerToken(r), req)
+	if err != nil {
+		var oauthErr *auth.OAuthError
+		switch {
+		case errors.As(err, &oauthErr):
+			redirect(w, r, req.RedirectURI, url.Values{
+				"error":             {oauthErr.Code},
+				"error_description": {oauthErr.Description},
+				"state":             {req.State},
+			})
+		case errors.Is(err, auth.ErrOAuthDisabled):
+			writeError(w, http.StatusNotFound, "oauth is disabled")
+		case errors.Is(err, auth.ErrInvalidClient):
+			writeError(w, http.StatusBadRequest, "unknown or disabled client")
+		case errors.Is(err, auth.ErrInvalidRedirectURI):
+			writeError(w, http.StatusBadRequest, "redirect_uri is not registered for the client")
+		case errors.Is(err, auth.ErrInvalidAccessToken):
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			writeError(w, http.StatusUnauthorized, "access token is invalid")
+		default:
+			writeError(w, http.StatusInternalServerError, "failed to authorize")
+		}
+		return
+	}
+
+	redirect(w, r, req.RedirectURI, url.Values{"code": {code}, "state": {req.State}})
+}
+
+// Token exchanges an authorization code for an access token and an ID token.
+// Clients authenticate with client_secret_basic or client_secret_post.
+// POST /oauth/token
+// grant_type=authorization_code&code=<code>&redirect_uri=<uri>&code_verifier=<verifier>
+func (h *handler) Token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+
+	req := domain.TokenRequest{
+		GrantType:    r.PostFormValue("grant_type"),
+		Code:         r.PostFormValue("code"),
+		RedirectURI:  r.PostFormValue("redirect_uri"),
+		CodeVerifier: r.PostFormValue("code_verifier"),
+		ClientId:     r.PostFormValue("client_id"),
+		ClientSecret: r.PostFormValue("client_secret"),
+	}
+	// Credentials of client_secret_basic are form-urlencoded, see RFC 6749 section 2.3.1.
+	id, secret, basic := r.BasicAuth()
+	if basic {
+		req.ClientId, _ = url.QueryUnescape(id)
+		req.ClientSecret, _ = url.QueryUnescape(secret)
+	}
+
+	tokens, err := h.auth.Token(r.Context(), req)
+	if err != nil {
+		var oauthErr *auth.OAuthError
+		switch {
+		case errors.As(err, &oauthErr):
+			writeJSON(w, http.StatusBadRequest, oauthErrorResponse{Error: oauthErr.Code, Description: oauthErr.Description})
+		case errors.Is(err, auth.ErrOAuthDisabled):
+			writeError(w, http.StatusNotFound, "oauth is disabled")
+		case errors.Is(err, auth.ErrInvalidClient):
+			if basic {
+				w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+			}
+			writeJSON(w, http.StatusUnauthorized, oauthErrorResponse{Error: "invalid_client"})
+		default:
+			writeJSON(w, http.StatusInternalServerError, oauthErrorResponse{Error: "server_error"})
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tokenResponse{
+		AccessToken: tokens.AccessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(tokens.ExpiresIn.Seconds()),
+		IDToken:     tokens.IDToken,
+		Scope:       tokens.Scope,
+	})
+}
+
+// UserInfo returns claims about the user of an access token issued to a client.
+// GET|POST /oauth/userinfo
+// Authorization: Bearer <access token>
+func (h *handler) UserInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	info, err := h.auth.UserInfo(r.Context(), bearerToken(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrOAuthDisabled):
+			writeError(w, http.StatusNotFound, "oauth is disabled")
+		case errors.Is(err, auth.ErrInvalidAccessToken):
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			writeError(w, http.StatusUnauthorized, "access token is invalid")
+		case errors.Is(err, auth.ErrInsufficientScope):
+			w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="openid"`)
+			writeError(w, http.StatusForbidden, "access token wasn't granted the openid scope")
+		default:
+			writeError(w, http.StatusInternalServerError, "failed to get user info")
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusOK, userInfoResponse{Subject: info.Subject, PreferredUsername: info.PreferredUsername})
+}
+
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if len(header) > len("Bearer ") && strings.EqualFold(header[:len("Bearer ")], "Bearer ") {
+		return header[len("Bearer "):]
+	}
+	return ""
+}
+
+// redirect sends the user agent to the registered redirect URI with params added to its query.
+func redirect(w http.ResponseWriter, r *http.Request, redirectURI string, params url.Values) {
+	target, err := url.Parse(redirectURI)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid redirect_uri")
+		return
+	}
+	query := target.Query()
+	for key, values := range params {
+		if values[0] != "" {
+			query.Set(key, values[0])
+		}
+	}
+	target.RawQuery = query.Encode()
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
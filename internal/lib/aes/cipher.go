@@ -0,0 +1,73 @@
+package aes
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+const (
+	// KeySize is the size of an AES-256 key in bytes.
+	KeySize = 32
+
+	// version1 is the envelope format produced by Encrypt:
+	// version byte || 12-byte nonce || AES-256-GCM ciphertext and tag.
+	version1 byte = 1
+
+	headerSize = 1
+)
+
+var (
+	ErrInvalidKey         = errors.New("aes: key must be 32 bytes")
+	ErrMalformed          = errors.New("aes: malformed ciphertext")
+	ErrUnsupportedVersion = errors.New("aes: unsupported ciphertext version")
+	ErrDecrypt            = errors.New("aes: message authentication failed")
+)
+
+// Cipher encrypts vault secrets with AES-256-GCM.
+// Ciphertexts are self-describing envelopes encoded with base64 so they can be
+// stored in TEXT columns.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// New creates Cipher for the given 32-byte key.
+func New(key []byte) (*Cipher, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("aes: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("aes: %w", err)
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// ParseKey decodes base64-encoded 32-byte key, e.g. from config or environment.
+func ParseKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("aes: decode key: %w", err)
+	}
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+// associatedData authenticates the envelope header together with
+// caller-supplied context so neither can be swapped without detection.
+func associatedData(header []byte, additionalData []byte) []byte {
+	ad := make([]byte, 0, len(header)+len(additionalData))
+	ad = append(ad, header...)
+	return append(ad, additionalData...)
+}
@@ -0,0 +1,154 @@
+package aes
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, KeySize)
+}
+
+func mustCipher(t *testing.T, key []byte) *Cipher {
+	t.Helper()
+	c, err := New(key)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return c
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     []byte
+		wantErr error
+	}{
+		{name: "32 bytes", key: testKey(1)},
+		{name: "empty", key: nil, wantErr: ErrInvalidKey},
+		{name: "16 bytes", key: make([]byte, 16), wantErr: ErrInvalidKey},
+		{name: "35 bytes", key: make([]byte, 35), wantErr: ErrInvalidKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.key); !errors.Is(err, tt.wantErr) {
+				t.Errorf("New() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+		wantErr bool
+	}{
+		{name: "valid", encoded: base64.StdEncoding.EncodeToString(testKey(7))},
+		{name: "not base64", encoded: "not base64!", wantErr: true},
+		{name: "short key", encoded: base64.StdEncoding.EncodeToString(make([]byte, 16)), wantErr: true},
+		{name: "empty", encoded: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParseKey(tt.encoded)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !bytes.Equal(key, testKey(7)) {
+				t.Errorf("ParseKey() = %x", key)
+			}
+		})
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	c := mustCipher(t, testKey(1))
+	tests := []struct {
+		name      string
+		plaintext []byte
+		ad        []byte
+	}{
+		{name: "empty", plaintext: []byte{}},
+		{name: "shorter than a block", plaintext: []byte("pass")},
+		{name: "longer than a block", plaintext: []byte(strings.Repeat("correct horse battery staple ", 10))},
+		{name: "with associated data", plaintext: []byte("secret"), ad: []byte("user")},
+		{name: "binary", plaintext: []byte{0, 1, 2, 255}, ad: []byte{0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sealed, err := c.Encrypt(tt.plaintext, tt.ad)
+			if err != nil {
+				t.Fatalf("Encrypt() error = %v", err)
+			}
+			got, err := c.Decrypt(sealed, tt.ad)
+			if err != nil {
+				t.Fatalf("Decrypt() error = %v", err)
+			}
+			if !bytes.Equal(got, tt.plaintext) {
+				t.Errorf("Decrypt() = %q, want %q", got, tt.plaintext)
+			}
+		})
+	}
+}
+
+func TestEncryptUsesFreshNonce(t *testing.T) {
+	c := mustCipher(t, testKey(1))
+	a, err := c.EncryptString("same", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := c.EncryptString("same", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == b {
+		t.Error("encrypting the same plaintext twice gave the same envelope")
+	}
+}
+
+func TestDecryptRejects(t *testing.T) {
+	c := mustCipher(t, testKey(1))
+	sealed, err := c.EncryptString("secret", []byte("user-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, _ := base64.StdEncoding.DecodeString(sealed)
+	modify := func(f func(raw []byte)) string {
+		out := bytes.Clone(raw)
+		f(out)
+		return base64.StdEncoding.EncodeToString(out)
+	}
+
+	tests := []struct {
+		name       string
+		cipher     *Cipher
+		ciphertext string
+		ad         []byte
+		wantErr    error
+	}{
+		{name: "other associated data", cipher: c, ciphertext: sealed, ad: []byte("user-2"), wantErr: ErrDecrypt},
+		{name: "missing associated data", cipher: c, ciphertext: sealed, wantErr: ErrDecrypt},
+		{name: "other key", cipher: mustCipher(t, testKey(2)), ciphertext: sealed, ad: []byte("user-1"), wantErr: ErrDecrypt},
+		{name: "flipped ciphertext bit", cipher: c, ad: []byte("user-1"), wantErr: ErrDecrypt,
+			ciphertext: modify(func(raw []byte) { raw[len(raw)-1] ^= 1 })},
+		{name: "flipped nonce bit", cipher: c, ad: []byte("user-1"), wantErr: ErrDecrypt,
+			ciphertext: modify(func(raw []byte) { raw[headerSize] ^= 1 })},
+		{name: "unknown version", cipher: c, ad: []byte("user-1"), wantErr: ErrUnsupportedVersion,
+			ciphertext: modify(func(raw []byte) { raw[0] = 2 })},
+		{name: "truncated", cipher: c, ad: []byte("user-1"), wantErr: ErrMalformed,
+			ciphertext: base64.StdEncoding.EncodeToString(raw[:headerSize+12])},
+		{name: "not base64", cipher: c, ciphertext: "%%%", wantErr: ErrMalformed},
+		{name: "empty", cipher: c, ciphertext: "", wantErr: ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.cipher.DecryptString(tt.ciphertext, tt.ad); !errors.Is(err, tt.wantErr) {
+				t.Errorf("DecryptString() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,19 +1,42 @@
 package aes
 
 import (
-	"crypto/aes"
-	"encoding/hex"
+	"encoding/base64"
 )
 
-func DecryptAES(ct string) string {
-	key := []byte(KEY)
-	ciphertext, _ := hex.DecodeString(ct)
+// Decrypt opens envelope produced by Encrypt.
+// It returns ErrMalformed, ErrUnsupportedVersion or ErrDecrypt when the
+// ciphertext was tampered with or additionalData doesn't match.
+func (c *Cipher) Decrypt(ciphertext string, additionalData []byte) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, ErrMalformed
+	}
 
-	c, _ := aes.NewCipher(key)
+	nonceSize := c.aead.NonceSize()
+	if len(raw) < headerSize+nonceSize+c.aead.Overhead() {
+		return nil, ErrMalformed
+	}
+	if raw[0] != version1 {
+		return nil, ErrUnsupportedVersion
+	}
 
-	pt := make([]byte, len(ciphertext))
-	c.Decrypt(pt, ciphertext)
+	nonce := raw[headerSize : headerSize+nonceSize]
+	sealed := raw[headerSize+nonceSize:]
 
-	s := string(pt[:])
-	return s
+	plaintext, err := c.aead.Open(nil, nonce, sealed, associatedData(raw[:headerSize], additionalData))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return plaintext, nil
+}
+
+// DecryptString is Decrypt for string secrets.
+func (c *Cipher) DecryptString(ciphertext string, additionalData []byte) (string, error) {
+	plaintext, err := c.Decrypt(ciphertext, additionalData)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
 }
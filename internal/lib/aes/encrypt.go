@@ -1,22 +1,31 @@
 package aes
 
 import (
-	"crypto/aes"
-	"encoding/hex"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
 )
 
-var KEY = "lkajsfKLJFDFkljfasdfk213jldg453jAFd"
+// Encrypt seals plaintext of any length with a random nonce and returns
+// base64-encoded envelope. additionalData is authenticated but not stored,
+// the same value must be passed to Decrypt.
+func (c *Cipher) Encrypt(plaintext []byte, additionalData []byte) (string, error) {
+	nonceSize := c.aead.NonceSize()
 
-func EncryptAES(plaintext string) string {
-	key := []byte(KEY)
-	// create cipher
-	c, _ := aes.NewCipher(key)
+	out := make([]byte, headerSize+nonceSize, headerSize+nonceSize+len(plaintext)+c.aead.Overhead())
+	out[0] = version1
 
-	// allocate space for ciphered data
-	out := make([]byte, len(plaintext))
+	nonce := out[headerSize : headerSize+nonceSize]
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("aes: generate nonce: %w", err)
+	}
 
-	// encrypt
-	c.Encrypt(out, []byte(plaintext))
-	// return hex string
-	return hex.EncodeToString(out)
+	out = c.aead.Seal(out, nonce, plaintext, associatedData(out[:headerSize], additionalData))
+
+	return base64.StdEncoding.EncodeToString(out), nil
+}
+
+// EncryptString is Encrypt for string secrets.
+func (c *Cipher) EncryptString(plaintext string, additionalData []byte) (string, error) {
+	return c.Encrypt([]byte(plaintext), additionalData)
 }
@@ -0,0 +1,70 @@
+package aes
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrUnknownKeyVersion = errors.New("aes: unknown key version")
+
+// Keyring holds versioned master keys.
+// New data is always sealed with the current version, older versions
+// are kept only to read data that hasn't been rotated yet.
+type Keyring struct {
+	current int
+	ciphers map[int]*Cipher
+}
+
+// NewKeyring creates Keyring from version -> key pairs.
+// keys must contain the current version.
+func NewKeyring(current int, keys map[int][]byte) (*Keyring, error) {
+	if _, ok := keys[current]; !ok {
+		return nil, fmt.Errorf("%w: current version %d", ErrUnknownKeyVersion, current)
+	}
+
+	ciphers := make(map[int]*Cipher, len(keys))
+	for version, key := range keys {
+		c, err := New(key)
+		if err != nil {
+			return nil, fmt.Errorf("key version %d: %w", version, err)
+		}
+		ciphers[version] = c
+	}
+
+	return &Keyring{current: current, ciphers: ciphers}, nil
+}
+
+// Current returns current key version and its cipher.
+func (k *Keyring) Current() (int, *Cipher) {
+	return k.current, k.ciphers[k.current]
+}
+
+// Cipher returns cipher for the given key version.
+func (k *Keyring) Cipher(version int) (*Cipher, error) {
+	c, ok := k.ciphers[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownKeyVersion, version)
+	}
+	return c, nil
+}
+
+// ParseKeyring creates Keyring from the current base64-encoded key and
+// base64-encoded keys of previous versions.
+func ParseKeyring(current int, currentKey string, previous map[int]string) (*Keyring, error) {
+	keys := make(map[int][]byte, len(previous)+1)
+	for version, encoded := range previous {
+		key, err := ParseKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key version %d: %w", version, err)
+		}
+		keys[version] = key
+	}
+
+	key, err := ParseKey(currentKey)
+	if err != nil {
+		return nil, fmt.Errorf("key version %d: %w", current, err)
+	}
+	keys[current] = key
+
+	return NewKeyring(current, keys)
+}
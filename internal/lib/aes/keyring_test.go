@@ -0,0 +1,88 @@
+package aes
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestParseKeyring(t *testing.T) {
+	encoded := func(b byte) string {
+		return base64.StdEncoding.EncodeToString(testKey(b))
+	}
+	tests := []struct {
+		name     string
+		current  int
+		key      string
+		previous map[int]string
+		wantErr  bool
+	}{
+		{name: "current only", current: 1, key: encoded(1)},
+		{name: "with previous", current: 2, key: encoded(2), previous: map[int]string{1: encoded(1)}},
+		{name: "invalid current", current: 1, key: "short", wantErr: true},
+		{name: "invalid previous", current: 2, key: encoded(2), previous: map[int]string{1: "short"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ring, err := ParseKeyring(tt.current, tt.key, tt.previous)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseKeyring() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if version, _ := ring.Current(); version != tt.current {
+				t.Errorf("Current() version = %d, want %d", version, tt.current)
+			}
+			for version := range tt.previous {
+				if _, err := ring.Cipher(version); err != nil {
+					t.Errorf("Cipher(%d) error = %v", version, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNewKeyringRequiresCurrent(t *testing.T) {
+	_, err := NewKeyring(2, map[int][]byte{1: testKey(1)})
+	if !errors.Is(err, ErrUnknownKeyVersion) {
+		t.Errorf("NewKeyring() error = %v, want %v", err, ErrUnknownKeyVersion)
+	}
+}
+
+func TestKeyringReadsPreviousVersions(t *testing.T) {
+	old, err := NewKeyring(1, map[int][]byte{1: testKey(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, oldCipher := old.Current()
+	sealed, err := oldCipher.EncryptString("dek", []byte("user"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ring, err := NewKeyring(2, map[int][]byte{1: testKey(1), 2: testKey(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		version int
+		wantErr error
+	}{
+		{name: "sealing version", version: 1},
+		{name: "current version", version: 2, wantErr: ErrDecrypt},
+		{name: "unknown version", version: 3, wantErr: ErrUnknownKeyVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ring.Cipher(tt.version)
+			if err == nil {
+				_, err = c.DecryptString(sealed, []byte("user"))
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package card
+
+import (
+	"strings"
+)
+
+const (
+	BrandVisa       = "visa"
+	BrandMastercard = "mastercard"
+	BrandAmex       = "amex"
+	BrandDiscover   = "discover"
+	BrandJCB        = "jcb"
+	BrandUnionPay   = "unionpay"
+	BrandMir        = "mir"
+	BrandUnknown    = "unknown"
+)
+
+// Normalize strips spaces and dashes people use to group card number digits.
+func Normalize(number string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(number)
+}
+
+// Luhn reports whether number consists of 12-19 digits with a valid Luhn check digit.
+func Luhn(number string) bool {
+	if len(number) < 12 || len(number) > 19 {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// Brand detects card brand by its IIN prefix.
+func Brand(number string) string {
+	switch {
+	case hasPrefixInRange(number, 4, 2200, 2204):
+		return BrandMir
+	case hasPrefixInRange(number, 2, 51, 55), hasPrefixInRange(number, 4, 2221, 2720):
+		return BrandMastercard
+	case strings.HasPrefix(number, "34"), strings.HasPrefix(number, "37"):
+		return BrandAmex
+	case strings.HasPrefix(number, "6011"), strings.HasPrefix(number, "65"), hasPrefixInRange(number, 3, 644, 649):
+		return BrandDiscover
+	case hasPrefixInRange(number, 4, 3528, 3589):
+		return BrandJCB
+	case strings.HasPrefix(number, "62"):
+		return BrandUnionPay
+	case strings.HasPrefix(number, "4"):
+		return BrandVisa
+	}
+	return BrandUnknown
+}
+
+// Mask hides all but the last four digits of number.
+func Mask(number string) string {
+	if len(number) <= 4 {
+		return number
+	}
+	return "**** " + number[len(number)-4:]
+}
+
+func hasPrefixInRange(number string, digits int, from, to int) bool {
+	if len(number) < digits {
+		return false
+	}
+	prefix := 0
+	for _, c := range number[:digits] {
+		if c < '0' || c > '9' {
+			return false
+		}
+		prefix = prefix*10 + int(c-'0')
+	}
+	return prefix >= from && prefix <= to
+}
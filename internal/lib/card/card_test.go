@@ -0,0 +1,76 @@
+package card
+
+import "testing"
+
+func TestLuhn(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+		want   bool
+	}{
+		{name: "visa", number: "4111111111111111", want: true},
+		{name: "mastercard", number: "5555555555554444", want: true},
+		{name: "amex", number: "378282246310005", want: true},
+		{name: "19 digits", number: "6011000000000000001", want: true},
+		{name: "wrong check digit", number: "4111111111111112"},
+		{name: "transposed digits", number: "4111111111111161"},
+		{name: "too short", number: "42424242426"},
+		{name: "too long", number: "41111111111111111111"},
+		{name: "not digits", number: "4111a11111111111"},
+		{name: "with spaces", number: "4111 1111 1111 1111"},
+		{name: "empty", number: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Luhn(tt.number); got != tt.want {
+				t.Errorf("Luhn(%q) = %v, want %v", tt.number, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBrand(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+	}{
+		{number: "4111111111111111", want: BrandVisa},
+		{number: "5555555555554444", want: BrandMastercard},
+		{number: "2223003122003222", want: BrandMastercard},
+		{number: "378282246310005", want: BrandAmex},
+		{number: "341111111111111", want: BrandAmex},
+		{number: "6011111111111117", want: BrandDiscover},
+		{number: "6445644564456445", want: BrandDiscover},
+		{number: "3530111333300000", want: BrandJCB},
+		{number: "6200000000000005", want: BrandUnionPay},
+		{number: "2200000000000004", want: BrandMir},
+		{number: "9999999999999995", want: BrandUnknown},
+		{number: "", want: BrandUnknown},
+	}
+	for _, tt := range tests {
+		if got := Brand(tt.number); got != tt.want {
+			t.Errorf("Brand(%q) = %q, want %q", tt.number, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeAndMask(t *testing.T) {
+	tests := []struct {
+		number     string
+		normalized string
+		masked     string
+	}{
+		{number: "4111 1111 1111 1111", normalized: "4111111111111111", masked: "**** 1111"},
+		{number: "5555-5555-5555-4444", normalized: "5555555555554444", masked: "**** 4444"},
+		{number: "123", normalized: "123", masked: "123"},
+	}
+	for _, tt := range tests {
+		normalized := Normalize(tt.number)
+		if normalized != tt.normalized {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.number, normalized, tt.normalized)
+		}
+		if got := Mask(normalized); got != tt.masked {
+			t.Errorf("Mask(%q) = %q, want %q", normalized, got, tt.masked)
+		}
+	}
+}
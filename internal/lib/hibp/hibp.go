@@ -0,0 +1,245 @@
+package hibp
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// PrefixLength is the number of hex characters of the SHA-1 hash a range is looked up by.
+const PrefixLength = 5
+
+var ErrInvalidPrefix = errors.New("invalid hash prefix")
+
+// Entry is a line of a HIBP-style range: the rest of the hash and how many times it was seen in breaches.
+type Entry struct {
+	Suffix string
+	Count  int64
+}
+
+// Corpus returns breached hashes starting with prefix, like the HIBP range API does.
+// Only the prefix of a password hash leaves the caller, so the corpus never learns the password.
+type Corpus interface {
+	Range(prefix string) ([]Entry, error)
+}
+
+// Count returns how many times password was seen in corpus breaches, 0 means it wasn't.
+func Count(corpus Corpus, password string) (int64, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	entries, err := corpus.Range(hash[:PrefixLength])
+	if err != nil {
+		return 0, err
+	}
+	for _, entry := range entries {
+		if entry.Suffix == hash[PrefixLength:] {
+			return entry.Count, nil
+		}
+	}
+	return 0, nil
+}
+
+// Open returns the corpus stored at path: either a directory of range files named <PREFIX>.txt
+// with SUFFIX:COUNT lines, or a single file of HASH:COUNT lines loaded into memory.
+// The file is reloaded on lookups once refresh has passed and it was modified, 0 disables reloading.
+func Open(path string, refresh time.Duration) (Corpus, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return &DirCorpus{dir: path}, nil
+	}
+	corpus := &FileCorpus{path: path, refresh: refresh}
+	if err := corpus.load(); err != nil {
+		return nil, err
+	}
+	return corpus, nil
+}
+
+// DirCorpus reads ranges from disk on every lookup, so it's always up to date.
+type DirCorpus struct {
+	dir string
+}
+
+func (d *DirCorpus) Range(prefix string) ([]Entry, error) {
+	prefix, err := normalizePrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(d.dir, prefix+".txt"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []Entry
+	err = scanLines(f, func(hash string, count int64) {
+		entries = append(entries, Entry{Suffix: hash, Count: count})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Name(), err)
+	}
+	return entries, nil
+}
+
+// FileCorpus keeps a whole corpus file in memory sorted by hash.
+type FileCorpus struct {
+	path    string
+	refresh time.Duration
+
+	mu        sync.RWMutex
+	records   []record
+	modTime   time.Time
+	checkedAt time.Time
+	reloading atomic.Bool
+}
+
+type record struct {
+	hash  [sha1.Size]byte
+	count int64
+}
+
+func (f *FileCorpus) Range(prefix string) ([]Entry, error) {
+	prefix, err := normalizePrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	f.maybeReload()
+
+	// An odd-length prefix is padded to the lowest and the highest hash starting with it.
+	lo, _ := hex.DecodeString(prefix + "0")
+	hi, _ := hex.DecodeString(prefix + "F")
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	i := sort.Search(len(f.records), func(i int) bool {
+		return bytes.Compare(f.records[i].hash[:len(lo)], lo) >= 0
+	})
+	var entries []Entry
+	for ; i < len(f.records) && bytes.Compare(f.records[i].hash[:len(hi)], hi) <= 0; i++ {
+		hash := strings.ToUpper(hex.EncodeToString(f.records[i].hash[:]))
+		entries = append(entries, Entry{Suffix: hash[PrefixLength:], Count: f.records[i].count})
+	}
+	return entries, nil
+}
+
+// maybeReload reloads the file in background if it was modified,
+// lookups keep using the previous records until it's done.
+func (f *FileCorpus) maybeReload() {
+	if f.refresh <= 0 {
+		return
+	}
+	f.mu.RLock()
+	due := time.Since(f.checkedAt) >= f.refresh
+	f.mu.RUnlock()
+	if !due || !f.reloading.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer f.reloading.Store(false)
+		// A failed reload keeps the previous records, it's retried after the next refresh interval.
+		_ = f.load()
+	}()
+}
+
+func (f *FileCorpus) load() error {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		f.markChecked()
+		return err
+	}
+	f.mu.RLock()
+	unchanged := !f.modTime.IsZero() && info.ModTime().Equal(f.modTime)
+	f.mu.RUnlock()
+	if unchanged {
+		f.markChecked()
+		return nil
+	}
+
+	file, err := os.Open(f.path)
+	if err != nil {
+		f.markChecked()
+		return err
+	}
+	defer file.Close()
+
+	var records []record
+	err = scanLines(file, func(hash string, count int64) {
+		var r record
+		if _, err := hex.Decode(r.hash[:], []byte(hash)); err == nil && len(hash) == 2*sha1.Size {
+			r.count = count
+			records = append(records, r)
+		}
+	})
+	if err != nil {
+		f.markChecked()
+		return fmt.Errorf("%s: %w", f.path, err)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return bytes.Compare(records[i].hash[:], records[j].hash[:]) < 0
+	})
+
+	f.mu.Lock()
+	f.records = records
+	f.modTime = info.ModTime()
+	f.checkedAt = time.Now()
+	f.mu.Unlock()
+	return nil
+}
+
+func (f *FileCorpus) markChecked() {
+	f.mu.Lock()
+	f.checkedAt = time.Now()
+	f.mu.Unlock()
+}
+
+func normalizePrefix(prefix string) (string, error) {
+	prefix = strings.ToUpper(prefix)
+	if len(prefix) != PrefixLength {
+		return "", ErrInvalidPrefix
+	}
+	if _, err := strconv.ParseUint(prefix, 16, 32); err != nil {
+		return "", ErrInvalidPrefix
+	}
+	return prefix, nil
+}
+
+// scanLines calls fn with the upper-cased hash and count of every HASH:COUNT line,
+// lines without a count are counted once.
+func scanLines(r io.Reader, fn func(hash string, count int64)) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		hash, countStr, found := strings.Cut(line, ":")
+		count := int64(1)
+		if found {
+			n, err := strconv.ParseInt(countStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid count %q", countStr)
+			}
+			count = n
+		}
+		fn(strings.ToUpper(hash), count)
+	}
+	return scanner.Err()
+}
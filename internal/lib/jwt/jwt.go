@@ -4,21 +4,40 @@ import (
 	"context"
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/s0vunia/password-manager/internal/domain"
-	"github.com/s0vunia/password-manager/internal/repositories/app"
+	"math"
 	"time"
 )
 
-func NewToken(user domain.User, app domain.App, duration time.Duration) (string, error) {
-	token := jwt.New(jwt.SigningMethodHS256)
+// AppProvider returns apps with their secrets unsealed.
+type AppProvider interface {
+	App(ctx context.Context, appID int64) (domain.App, error)
+}
+
+// KeySource looks up public keys of the signing keys by kid.
+type KeySource interface {
+	VerificationKey(ctx context.Context, kid string) (*SigningKey, error)
+}
 
+// NewToken issues an access token of the user's session. Its jti and iat,
+// which has millisecond precision, let the token be revoked before it expires.
+// The token is signed with key and carries its kid.
+func NewToken(user domain.User, app domain.App, sessionId uuid.UUID, duration time.Duration, key *SigningKey) (string, error) {
+	token := jwt.New(key.method())
+	token.Header["kid"] = key.ID
+
+	now := time.Now()
 	claims := token.Claims.(jwt.MapClaims)
 	claims["uid"] = user.ID
 	claims["login"] = user.Login
-	claims["exp"] = time.Now().Add(duration).Unix()
+	claims["exp"] = now.Add(duration).Unix()
+	claims["iat"] = float64(now.UnixMilli()) / 1000
+	claims["jti"] = uuid.New()
 	claims["app_id"] = app.ID
+	claims["sid"] = sessionId
 
-	tokenString, err := token.SignedString([]byte(app.Secret))
+	tokenString, err := token.SignedString(key.Private)
 	if err != nil {
 		return "", err
 	}
@@ -27,7 +46,10 @@ func NewToken(user domain.User, app domain.App, duration time.Duration) (string,
 }
 
 // ProcessJWT Функция для извлечения app_id из JWT и проверки его валидности
-func ProcessJWT(ctx context.Context, tokenString string, appRepo app.Repository) (error, *jwt.Token) {
+// Tokens are verified with the public key of their kid from keys, tokens without kid and tokens
+// signed with another algorithm than the key's, HMAC included, are rejected. The app of the token
+// must exist and be enabled, so disabling an app cuts off its tokens right away.
+func ProcessJWT(ctx context.Context, tokenString string, appRepo AppProvider, keys KeySource) (error, *jwt.Token) {
 	// Извлечение app_id из JWT без проверки подписи
 	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
 	if err != nil {
@@ -39,6 +61,21 @@ func ProcessJWT(ctx context.Context, tokenString string, appRepo app.Repository)
 		return fmt.Errorf("invalid JWT"), nil
 	}
 
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		return fmt.Errorf("kid not found in JWT"), nil
+	}
+	key, err := keys.VerificationKey(ctx, kid)
+	if err != nil {
+		return fmt.Errorf("failed to get signing key: %w", err), nil
+	}
+	_, err = jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return key.Private.Public(), nil
+	}, jwt.WithValidMethods([]string{key.Algorithm}))
+	if err != nil {
+		return fmt.Errorf("invalid JWT: %w", err), nil
+	}
+
 	appID, ok := claims["app_id"].(float64)
 	if !ok {
 		return fmt.Errorf("app_id not found in JWT"), nil
@@ -47,17 +84,17 @@ func ProcessJWT(ctx context.Context, tokenString string, appRepo app.Repository)
 	if err != nil {
 		return fmt.Errorf("failed to get app: %w", err), nil
 	}
-
-	// Проверка валидности JWT с использованием полученного секрета
-	_, err = jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(app.Secret), nil
-	})
-
-	if err != nil {
-		return fmt.Errorf("invalid JWT: %w", err), nil
+	if app.Disabled {
+		return fmt.Errorf("app %d is disabled", app.ID), nil
 	}
 	return nil, token
 }
+
+// IssuedAt returns the iat claim, zero time if the token has none.
+func IssuedAt(claims jwt.MapClaims) time.Time {
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		return time.Time{}
+	}
+	return time.UnixMilli(int64(math.Round(iat * 1000)))
+}
@@ -0,0 +1,119 @@
+package jwt
+
+import (
+	"context"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"testing"
+	"time"
+)
+
+type apps map[int64]domain.App
+
+func (a apps) App(ctx context.Context, appID int64) (domain.App, error) {
+	app, ok := a[appID]
+	if !ok {
+		return domain.App{}, ErrUnknownKey
+	}
+	return app, nil
+}
+
+type keyRing map[string]*SigningKey
+
+func (k keyRing) VerificationKey(ctx context.Context, kid string) (*SigningKey, error) {
+	key, ok := k[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+func mustKey(t *testing.T, algorithm string) *SigningKey {
+	t.Helper()
+	key, err := GenerateKey(algorithm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestProcessJWT(t *testing.T) {
+	eddsa := mustKey(t, AlgorithmEdDSA)
+	rs256 := mustKey(t, AlgorithmRS256)
+	unpublished := mustKey(t, AlgorithmEdDSA)
+	ring := keyRing{eddsa.ID: eddsa, rs256.ID: rs256}
+	app := domain.App{ID: 1, Name: "test"}
+	// sharedSecret stands for any value a client knows, like its client secret.
+	const sharedSecret = "client secret"
+	registered := apps{1: app, 3: {ID: 3, Name: "disabled", Disabled: true}}
+	user := domain.User{ID: uuid.New(), Login: "alice"}
+
+	issue := func(key *SigningKey, app domain.App, ttl time.Duration) string {
+		token, err := NewToken(user, app, uuid.New(), ttl, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	// hmac signs claims of a valid token with a shared secret, as HS256 tokens were.
+	hmac := func(kid string, secret string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"uid": user.ID, "login": user.Login, "app_id": app.ID, "exp": time.Now().Add(time.Hour).Unix(),
+		})
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString([]byte(secret))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "EdDSA", token: issue(eddsa, app, time.Hour)},
+		{name: "RS256", token: issue(rs256, app, time.Hour)},
+		{name: "HS256 without kid", token: hmac("", sharedSecret), wantErr: true},
+		{name: "HS256 with a kid", token: hmac(eddsa.ID, sharedSecret), wantErr: true},
+		{name: "HS256 keyed with the public key", token: hmac(eddsa.ID, eddsa.JWK().X), wantErr: true},
+		{name: "unknown kid", token: issue(unpublished, app, time.Hour), wantErr: true},
+		{name: "expired", token: issue(eddsa, app, -time.Minute), wantErr: true},
+		{name: "deleted app", token: issue(eddsa, domain.App{ID: 2}, time.Hour), wantErr: true},
+		{name: "disabled app", token: issue(eddsa, registered[3], time.Hour), wantErr: true},
+		{name: "garbage", token: "not a token", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err, token := ProcessJWT(context.Background(), tt.token, registered, ring)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ProcessJWT() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			claims := token.Claims.(jwt.MapClaims)
+			if claims["login"] != user.Login {
+				t.Errorf("login claim = %v, want %s", claims["login"], user.Login)
+			}
+		})
+	}
+}
+
+func TestProcessJWTRejectsKeyOfOtherAlgorithm(t *testing.T) {
+	rs256 := mustKey(t, AlgorithmRS256)
+	eddsa := mustKey(t, AlgorithmEdDSA)
+	token, err := NewToken(domain.User{ID: uuid.New()}, domain.App{ID: 1}, uuid.New(), time.Hour, eddsa)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The kid of the token points at an RS256 key, so the EdDSA signature must not be accepted.
+	ring := keyRing{eddsa.ID: rs256}
+	if err, _ := ProcessJWT(context.Background(), token, apps{1: {ID: 1}}, ring); err == nil {
+		t.Error("ProcessJWT() accepted a token signed with another algorithm than its key's")
+	}
+}
@@ -0,0 +1,164 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"math/big"
+)
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+
+	rsaKeySize = 2048
+)
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("jwt: unsupported signing algorithm")
+	ErrUnknownKey           = errors.New("jwt: unknown signing key")
+)
+
+// SigningKey is an asymmetric key tokens are signed with. Its ID is the RFC 7638
+// thumbprint of the public key and goes to the kid header of signed tokens.
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer
+}
+
+// JWK is a public key in the JSON Web Key format, only fields of RSA and Ed25519 keys are present.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// SupportedAlgorithm reports whether keys of the algorithm can be generated.
+func SupportedAlgorithm(algorithm string) bool {
+	return algorithm == AlgorithmRS256 || algorithm == AlgorithmEdDSA
+}
+
+// GenerateKey creates a random RS256 or EdDSA (Ed25519) key.
+func GenerateKey(algorithm string) (*SigningKey, error) {
+	var private crypto.Signer
+	var err error
+	switch algorithm {
+	case AlgorithmRS256:
+		private, err = rsa.GenerateKey(rand.Reader, rsaKeySize)
+	case AlgorithmEdDSA:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newSigningKey(algorithm, private)
+}
+
+// ParsePrivateKey restores a key from the PKCS #8 form returned by MarshalPrivate.
+func ParsePrivateKey(algorithm string, der []byte) (*SigningKey, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("jwt: parse private key: %w", err)
+	}
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		if algorithm == AlgorithmRS256 {
+			return newSigningKey(algorithm, key)
+		}
+	case ed25519.PrivateKey:
+		if algorithm == AlgorithmEdDSA {
+			return newSigningKey(algorithm, key)
+		}
+	}
+	return nil, fmt.Errorf("%w: %q doesn't match the key type", ErrUnsupportedAlgorithm, algorithm)
+}
+
+// MarshalPrivate returns the private key in PKCS #8 form.
+func (k *SigningKey) MarshalPrivate() ([]byte, error) {
+	return x509.MarshalPKCS8PrivateKey(k.Private)
+}
+
+// JWK returns the public part of the key.
+func (k *SigningKey) JWK() JWK {
+	jwk := publicJWK(k.Private.Public())
+	jwk.Kid = k.ID
+	jwk.Use = "sig"
+	jwk.Alg = k.Algorithm
+	return jwk
+}
+
+func (k *SigningKey) method() jwt.SigningMethod {
+	if k.Algorithm == AlgorithmRS256 {
+		return jwt.SigningMethodRS256
+	}
+	return jwt.SigningMethodEdDSA
+}
+
+func newSigningKey(algorithm string, private crypto.Signer) (*SigningKey, error) {
+	id, err := thumbprint(publicJWK(private.Public()))
+	if err != nil {
+		return nil, err
+	}
+	return &SigningKey{ID: id, Algorithm: algorithm, Private: private}, nil
+}
+
+func publicJWK(public crypto.PublicKey) JWK {
+	switch public := public.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			N:   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+		}
+	case ed25519.PublicKey:
+		return JWK{Kty: "OKP", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(public)}
+	}
+	return JWK{}
+}
+
+// thumbprint is the RFC 7638 SHA-256 thumbprint of the public key, members are in lexicographic order.
+func thumbprint(jwk JWK) (string, error) {
+	var members any
+	switch jwk.Kty {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	case "OKP":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	default:
+		return "", ErrUnsupportedAlgorithm
+	}
+	data, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,71 @@
+package jwt
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewOAuthToken issues an access token to an OAuth client. It isn't bound to a session,
+// carries the granted scope and is accepted only by the userinfo endpoint, not by the API.
+func NewOAuthToken(code domain.AuthorizationCode, app domain.App, issuer string, duration time.Duration, key *SigningKey) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":    issuer,
+		"sub":    code.UserId.String(),
+		"aud":    ClientID(app.ID),
+		"uid":    code.UserId,
+		"login":  code.Login,
+		"exp":    now.Add(duration).Unix(),
+		"iat":    float64(now.UnixMilli()) / 1000,
+		"jti":    uuid.New(),
+		"app_id": app.ID,
+		"scope":  code.Scope,
+	}
+	return sign(claims, key)
+}
+
+// NewIDToken issues the OpenID Connect ID token of the authorization, its audience is the client.
+// preferred_username is present only if the profile scope was granted.
+func NewIDToken(code domain.AuthorizationCode, app domain.App, issuer string, duration time.Duration, key *SigningKey) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":       issuer,
+		"sub":       code.UserId.String(),
+		"aud":       ClientID(app.ID),
+		"exp":       now.Add(duration).Unix(),
+		"iat":       now.Unix(),
+		"auth_time": code.AuthTime.Unix(),
+	}
+	if code.Nonce != "" {
+		claims["nonce"] = code.Nonce
+	}
+	if HasScope(code.Scope, domain.ScopeProfile) {
+		claims["preferred_username"] = code.Login
+	}
+	return sign(claims, key)
+}
+
+// HasScope reports whether the space-delimited scope contains the value.
+func HasScope(scope string, value string) bool {
+	for _, s := range strings.Fields(scope) {
+		if s == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientID is the OAuth client_id of the app.
+func ClientID(appId int) string {
+	return strconv.Itoa(appId)
+}
+
+func sign(claims jwt.MapClaims, key *SigningKey) (string, error) {
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.Private)
+}
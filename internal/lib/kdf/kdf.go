@@ -0,0 +1,51 @@
+package kdf
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/hkdf"
+	"io"
+)
+
+const (
+	SaltSize     = 16
+	KeySize      = 32
+	AuthHashSize = 32
+)
+
+// authHashInfo separates auth hash from other keys derived from the master key.
+var authHashInfo = []byte("password-manager auth hash")
+
+// DeriveMasterKey derives client master key from the master password.
+// The master key encrypts vault items on the client and must never leave it.
+func DeriveMasterKey(password string, params domain.KDFParams) []byte {
+	return argon2.IDKey([]byte(password), params.Salt, params.Iterations, params.Memory, params.Parallelism, KeySize)
+}
+
+// AuthHash derives the value clients send instead of the master password.
+// It can't be used to recover the master key.
+func AuthHash(masterKey []byte) (string, error) {
+	hash := make([]byte, AuthHashSize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, masterKey, nil, authHashInfo), hash); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(hash), nil
+}
+
+// IsAuthHash reports whether s looks like a value produced by AuthHash.
+func IsAuthHash(s string) bool {
+	raw, err := base64.StdEncoding.DecodeString(s)
+	return err == nil && len(raw) == AuthHashSize
+}
+
+// Salt returns salt for login derived from secret. It is deterministic so
+// that parameters published for unknown logins are indistinguishable from real ones
+// and match the salt stored on registration.
+func Salt(secret []byte, login string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(login))
+	return mac.Sum(nil)[:SaltSize]
+}
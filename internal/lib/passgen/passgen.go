@@ -0,0 +1,248 @@
+package passgen
+
+import (
+	"bufio"
+	"crypto/rand"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+const (
+	MinLength = 4
+	MaxLength = 128
+	MinWords  = 3
+	MaxWords  = 20
+
+	DefaultLength    = 20
+	DefaultWords     = 6
+	DefaultSeparator = "-"
+)
+
+const (
+	lowerChars     = "abcdefghijklmnopqrstuvwxyz"
+	upperChars     = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitChars     = "0123456789"
+	symbolChars    = "!@#$%^&*()-_=+[]{};:,.<>?/~|"
+	ambiguousChars = "Il1O0o|"
+)
+
+var ErrInvalidPolicy = errors.New("invalid password policy")
+
+// effLargeWordlist is EFF's large wordlist for dice-generated passphrases,
+// https://www.eff.org/deeplinks/2016/07/new-wordlists-random-passphrases (CC BY 3.0).
+//
+//go:embed eff_large_wordlist.txt
+var effLargeWordlist string
+
+var wordlist = parseWordlist(effLargeWordlist)
+
+// Policy describes a random password. Every enabled class is guaranteed to appear
+// at least its minimum number of times, if no class is enabled all of them are.
+type Policy struct {
+	Length           int
+	Lower            bool
+	Upper            bool
+	Digits           bool
+	Symbols          bool
+	MinLower         int
+	MinUpper         int
+	MinDigits        int
+	MinSymbols       int
+	ExcludeAmbiguous bool
+}
+
+// PassphrasePolicy describes a diceware-style passphrase made of words from the bundled wordlist.
+type PassphrasePolicy struct {
+	Words      int
+	Separator  string
+	Capitalize bool
+}
+
+type class struct {
+	chars []rune
+	min   int
+}
+
+// Generate returns a password drawn uniformly from all passwords satisfying the policy
+// together with its entropy in bits.
+func Generate(policy Policy) (string, float64, error) {
+	classes, err := policy.classes()
+	if err != nil {
+		return "", 0, err
+	}
+
+	// ways[j][n] is the number of strings of length n built from the first j classes
+	// that satisfy their minimums, it lets us pick class counts with the right weights.
+	ways := make([][]*big.Int, len(classes)+1)
+	ways[0] = make([]*big.Int, policy.Length+1)
+	for n := range ways[0] {
+		ways[0][n] = new(big.Int)
+	}
+	ways[0][0].SetInt64(1)
+	for j, c := range classes {
+		ways[j+1] = make([]*big.Int, policy.Length+1)
+		for n := 0; n <= policy.Length; n++ {
+			sum := new(big.Int)
+			for k := c.min; k <= n; k++ {
+				sum.Add(sum, countWays(n, k, len(c.chars), ways[j][n-k]))
+			}
+			ways[j+1][n] = sum
+		}
+	}
+	total := ways[len(classes)][policy.Length]
+	if total.Sign() == 0 {
+		return "", 0, fmt.Errorf("%w: minimum counts exceed length", ErrInvalidPolicy)
+	}
+
+	// Walk the table back to choose how many characters every class gets.
+	counts := make([]int, len(classes))
+	n := policy.Length
+	for j := len(classes) - 1; j >= 0; j-- {
+		r, err := rand.Int(rand.Reader, ways[j+1][n])
+		if err != nil {
+			return "", 0, err
+		}
+		for k := classes[j].min; k <= n; k++ {
+			w := countWays(n, k, len(classes[j].chars), ways[j][n-k])
+			if r.Cmp(w) < 0 {
+				counts[j] = k
+				break
+			}
+			r.Sub(r, w)
+		}
+		n -= counts[j]
+	}
+
+	password := make([]rune, 0, policy.Length)
+	for j, c := range classes {
+		for i := 0; i < counts[j]; i++ {
+			idx, err := randomIndex(len(c.chars))
+			if err != nil {
+				return "", 0, err
+			}
+			password = append(password, c.chars[idx])
+		}
+	}
+	if err := shuffle(password); err != nil {
+		return "", 0, err
+	}
+	return string(password), log2(total), nil
+}
+
+// Passphrase returns words picked uniformly from the bundled wordlist together with the entropy in bits.
+func Passphrase(policy PassphrasePolicy) (string, float64, error) {
+	if policy.Words < MinWords || policy.Words > MaxWords {
+		return "", 0, fmt.Errorf("%w: words must be between %d and %d", ErrInvalidPolicy, MinWords, MaxWords)
+	}
+
+	words := make([]string, policy.Words)
+	for i := range words {
+		idx, err := randomIndex(len(wordlist))
+		if err != nil {
+			return "", 0, err
+		}
+		words[i] = wordlist[idx]
+		if policy.Capitalize {
+			r := []rune(words[i])
+			r[0] = unicode.ToUpper(r[0])
+			words[i] = string(r)
+		}
+	}
+	return strings.Join(words, policy.Separator), float64(policy.Words) * math.Log2(float64(len(wordlist))), nil
+}
+
+func (p Policy) classes() ([]class, error) {
+	if p.Length < MinLength || p.Length > MaxLength {
+		return nil, fmt.Errorf("%w: length must be between %d and %d", ErrInvalidPolicy, MinLength, MaxLength)
+	}
+	if p.MinLower < 0 || p.MinUpper < 0 || p.MinDigits < 0 || p.MinSymbols < 0 {
+		return nil, fmt.Errorf("%w: minimum counts can't be negative", ErrInvalidPolicy)
+	}
+	if !p.Lower && !p.Upper && !p.Digits && !p.Symbols {
+		p.Lower, p.Upper, p.Digits, p.Symbols = true, true, true, true
+	}
+
+	var classes []class
+	add := func(enabled bool, chars string, min int) error {
+		if !enabled {
+			if min > 0 {
+				return fmt.Errorf("%w: minimum count set for a disabled class", ErrInvalidPolicy)
+			}
+			return nil
+		}
+		if p.ExcludeAmbiguous {
+			chars = strings.Map(func(r rune) rune {
+				if strings.ContainsRune(ambiguousChars, r) {
+					return -1
+				}
+				return r
+			}, chars)
+		}
+		classes = append(classes, class{chars: []rune(chars), min: min})
+		return nil
+	}
+	for _, err := range []error{
+		add(p.Lower, lowerChars, p.MinLower),
+		add(p.Upper, upperChars, p.MinUpper),
+		add(p.Digits, digitChars, p.MinDigits),
+		add(p.Symbols, symbolChars, p.MinSymbols),
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return classes, nil
+}
+
+// countWays returns C(n, k) * size^k * rest: the number of ways to spread k characters
+// of a class over n positions with the other positions filled in rest ways.
+func countWays(n, k, size int, rest *big.Int) *big.Int {
+	w := new(big.Int).Binomial(int64(n), int64(k))
+	w.Mul(w, new(big.Int).Exp(big.NewInt(int64(size)), big.NewInt(int64(k)), nil))
+	return w.Mul(w, rest)
+}
+
+func randomIndex(n int) (int, error) {
+	idx, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(idx.Int64()), nil
+}
+
+// shuffle is the Fisher-Yates shuffle driven by crypto/rand.
+func shuffle(r []rune) error {
+	for i := len(r) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return err
+		}
+		r[i], r[j] = r[j], r[i]
+	}
+	return nil
+}
+
+func log2(x *big.Int) float64 {
+	mant := new(big.Float)
+	exp := new(big.Float).SetInt(x).MantExp(mant)
+	m, _ := mant.Float64()
+	return float64(exp) + math.Log2(m)
+}
+
+// parseWordlist reads lines of the form "<dice rolls>\t<word>".
+func parseWordlist(list string) []string {
+	var words []string
+	scanner := bufio.NewScanner(strings.NewReader(list))
+	for scanner.Scan() {
+		_, word, ok := strings.Cut(scanner.Text(), "\t")
+		if ok && word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
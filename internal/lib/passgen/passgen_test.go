@@ -0,0 +1,149 @@
+package passgen
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func count(s, chars string) int {
+	n := 0
+	for _, r := range s {
+		if strings.ContainsRune(chars, r) {
+			n++
+		}
+	}
+	return n
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  Policy
+		allowed string
+		entropy float64
+	}{
+		{name: "digits only", policy: Policy{Length: 6, Digits: true},
+			allowed: digitChars, entropy: 6 * math.Log2(10)},
+		{name: "lower only", policy: Policy{Length: 10, Lower: true},
+			allowed: lowerChars, entropy: 10 * math.Log2(26)},
+		{name: "no class means all", policy: Policy{Length: 8},
+			allowed: lowerChars + upperChars + digitChars + symbolChars, entropy: 8 * math.Log2(26+26+10+28)},
+		{name: "minimums fill the length", policy: Policy{Length: 4, Lower: true, Digits: true, MinDigits: 4},
+			allowed: digitChars, entropy: 4 * math.Log2(10)},
+		{name: "minimums", policy: Policy{Length: 4, Lower: true, Digits: true, MinLower: 1, MinDigits: 1},
+			allowed: lowerChars + digitChars, entropy: math.Log2(math.Pow(36, 4) - math.Pow(26, 4) - math.Pow(10, 4))},
+		{name: "without ambiguous", policy: Policy{Length: 12, Lower: true, Digits: true, ExcludeAmbiguous: true},
+			allowed: "abcdefghijkmnpqrstuvwxyz23456789", entropy: 12 * math.Log2(24+8)},
+		{name: "max length", policy: Policy{Length: MaxLength, Upper: true},
+			allowed: upperChars, entropy: MaxLength * math.Log2(26)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				password, entropy, err := Generate(tt.policy)
+				if err != nil {
+					t.Fatalf("Generate() error = %v", err)
+				}
+				if len(password) != tt.policy.Length {
+					t.Fatalf("len(%q) = %d, want %d", password, len(password), tt.policy.Length)
+				}
+				if n := count(password, tt.allowed); n != len(password) {
+					t.Fatalf("%q has characters outside %q", password, tt.allowed)
+				}
+				if count(password, lowerChars) < tt.policy.MinLower || count(password, upperChars) < tt.policy.MinUpper ||
+					count(password, digitChars) < tt.policy.MinDigits || count(password, symbolChars) < tt.policy.MinSymbols {
+					t.Fatalf("%q doesn't satisfy minimums of %+v", password, tt.policy)
+				}
+				if math.Abs(entropy-tt.entropy) > 1e-9 {
+					t.Fatalf("entropy = %v, want %v", entropy, tt.entropy)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateInvalidPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Policy
+	}{
+		{name: "too short", policy: Policy{Length: MinLength - 1}},
+		{name: "too long", policy: Policy{Length: MaxLength + 1}},
+		{name: "negative minimum", policy: Policy{Length: 8, Lower: true, MinLower: -1}},
+		{name: "minimum of disabled class", policy: Policy{Length: 8, Lower: true, MinDigits: 1}},
+		{name: "minimums exceed length", policy: Policy{Length: 4, Lower: true, Digits: true, MinLower: 3, MinDigits: 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := Generate(tt.policy); !errors.Is(err, ErrInvalidPolicy) {
+				t.Errorf("Generate() error = %v, want %v", err, ErrInvalidPolicy)
+			}
+		})
+	}
+}
+
+// TestGenerateIsUniform checks that class counts follow the share of passwords having them,
+// a generator placing the required characters first and filling the rest would skew them.
+func TestGenerateIsUniform(t *testing.T) {
+	const samples = 20000
+	policy := Policy{Length: 4, Lower: true, Digits: true, MinLower: 1, MinDigits: 1}
+	total := math.Pow(36, 4) - math.Pow(26, 4) - math.Pow(10, 4)
+	binomial := []float64{1, 4, 6, 4, 1}
+
+	got := make([]int, policy.Length+1)
+	for i := 0; i < samples; i++ {
+		password, _, err := Generate(policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got[count(password, digitChars)]++
+	}
+	for digits := 1; digits < policy.Length; digits++ {
+		want := binomial[digits] * math.Pow(10, float64(digits)) * math.Pow(26, float64(policy.Length-digits)) / total
+		if share := float64(got[digits]) / samples; math.Abs(share-want) > 0.02 {
+			t.Errorf("share of passwords with %d digits = %.3f, want %.3f", digits, share, want)
+		}
+	}
+}
+
+func TestPassphrase(t *testing.T) {
+	if len(wordlist) != 7776 {
+		t.Fatalf("wordlist has %d words, want 7776", len(wordlist))
+	}
+	tests := []struct {
+		name    string
+		policy  PassphrasePolicy
+		wantErr bool
+	}{
+		{name: "default", policy: PassphrasePolicy{Words: DefaultWords, Separator: DefaultSeparator}},
+		{name: "capitalized", policy: PassphrasePolicy{Words: MinWords, Separator: " ", Capitalize: true}},
+		{name: "max words", policy: PassphrasePolicy{Words: MaxWords, Separator: "."}},
+		{name: "too few words", policy: PassphrasePolicy{Words: MinWords - 1, Separator: "-"}, wantErr: true},
+		{name: "too many words", policy: PassphrasePolicy{Words: MaxWords + 1, Separator: "-"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			phrase, entropy, err := Passphrase(tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Passphrase() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			words := strings.Split(phrase, tt.policy.Separator)
+			if len(words) != tt.policy.Words {
+				t.Fatalf("%q has %d words, want %d", phrase, len(words), tt.policy.Words)
+			}
+			for _, w := range words {
+				if tt.policy.Capitalize && strings.ToUpper(w[:1]) != w[:1] {
+					t.Errorf("word %q isn't capitalized", w)
+				}
+			}
+			if want := float64(tt.policy.Words) * math.Log2(7776); math.Abs(entropy-want) > 1e-9 {
+				t.Errorf("entropy = %v, want %v", entropy, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package sshkey
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/ssh"
+	"strings"
+)
+
+var (
+	ErrInvalidPrivateKey = errors.New("invalid private key")
+	ErrInvalidPublicKey  = errors.New("invalid public key")
+	ErrPassphrase        = errors.New("private key passphrase is missing or wrong")
+	ErrKeyMismatch       = errors.New("public key doesn't match private key")
+)
+
+// Key describes validated key pair.
+type Key struct {
+	// Type is the SSH key algorithm, e.g. ssh-ed25519.
+	Type string
+	// PublicKey is in authorized_keys format without comment.
+	PublicKey string
+	// Fingerprint is SHA256 fingerprint as printed by ssh-keygen -l.
+	Fingerprint string
+}
+
+// Parse validates OpenSSH or PEM (PKCS#1, PKCS#8, SEC1) private key, decrypting
+// it with passphrase if it's protected, and derives its public key.
+// If publicKey is set, it must be the public half of the private key.
+func Parse(privateKey, passphrase, publicKey string) (*Key, error) {
+	signer, err := parsePrivateKey([]byte(privateKey), passphrase)
+	if err != nil {
+		return nil, err
+	}
+	pub := signer.PublicKey()
+
+	if strings.TrimSpace(publicKey) != "" {
+		provided, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPublicKey, err)
+		}
+		if !bytes.Equal(provided.Marshal(), pub.Marshal()) {
+			return nil, ErrKeyMismatch
+		}
+	}
+
+	return describe(pub), nil
+}
+
+// ParsePublicKey validates public key in authorized_keys format and describes it.
+// It's used when the private key is encrypted on the client and can't be parsed.
+func ParsePublicKey(publicKey string) (*Key, error) {
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPublicKey, err)
+	}
+	return describe(pub), nil
+}
+
+func describe(pub ssh.PublicKey) *Key {
+	return &Key{
+		Type:        pub.Type(),
+		PublicKey:   strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub))),
+		Fingerprint: ssh.FingerprintSHA256(pub),
+	}
+}
+
+func parsePrivateKey(pemBytes []byte, passphrase string) (ssh.Signer, error) {
+	if passphrase != "" {
+		signer, err := ssh.ParsePrivateKeyWithPassphrase(pemBytes, []byte(passphrase))
+		if err != nil {
+			if errors.Is(err, x509.IncorrectPasswordError) {
+				return nil, ErrPassphrase
+			}
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPrivateKey, err)
+		}
+		return signer, nil
+	}
+
+	signer, err := ssh.ParsePrivateKey(pemBytes)
+	if err != nil {
+		var missing *ssh.PassphraseMissingError
+		if errors.As(err, &missing) {
+			return nil, ErrPassphrase
+		}
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPrivateKey, err)
+	}
+	return signer, nil
+}
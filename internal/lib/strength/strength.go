@@ -0,0 +1,38 @@
+package strength
+
+import (
+	"github.com/ccojocar/zxcvbn-go"
+)
+
+// MaxScoredLength bounds the part of the password fed to the pattern matcher,
+// matching is superlinear and anything longer is strong enough anyway.
+const MaxScoredLength = 100
+
+// Result is a zxcvbn estimate of a password, it never contains parts of the password itself.
+// Score is 0 (too guessable) to 4 (very unguessable), Patterns are the kinds of weaknesses
+// found, e.g. "dictionary", "spatial", "sequence", "repeat" or "date".
+type Result struct {
+	Score    int
+	Entropy  float64
+	Patterns []string
+}
+
+// Estimate scores password, userInputs like the login or the item name are treated as a dictionary.
+func Estimate(password string, userInputs []string) Result {
+	runes := []rune(password)
+	if len(runes) > MaxScoredLength {
+		password = string(runes[:MaxScoredLength])
+	}
+
+	estimate := zxcvbn.PasswordStrength(password, userInputs)
+	result := Result{Score: estimate.Score, Entropy: estimate.Entropy}
+	seen := make(map[string]bool)
+	for _, m := range estimate.MatchSequence {
+		if m.Pattern == "" || m.Pattern == "bruteforce" || seen[m.Pattern] {
+			continue
+		}
+		seen[m.Pattern] = true
+		result.Patterns = append(result.Patterns, m.Pattern)
+	}
+	return result
+}
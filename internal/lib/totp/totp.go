@@ -0,0 +1,196 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	AlgorithmSHA1   = "SHA1"
+	AlgorithmSHA256 = "SHA256"
+	AlgorithmSHA512 = "SHA512"
+
+	DefaultDigits = 6
+	DefaultPeriod = 30 * time.Second
+
+	// secretSize is the size of generated secrets, RFC 4226 recommends 160 bits.
+	secretSize = 20
+)
+
+var ErrInvalidURI = errors.New("invalid otpauth uri")
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Key is a parsed otpauth://totp URI.
+type Key struct {
+	Issuer    string
+	Account   string
+	Secret    []byte
+	Algorithm string
+	Digits    int
+	Period    time.Duration
+}
+
+// Parse validates an otpauth://totp URI as described by the Key Uri Format used by authenticator apps.
+func Parse(uri string) (*Key, error) {
+	u, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidURI, err)
+	}
+	if u.Scheme != "otpauth" {
+		return nil, fmt.Errorf("%w: scheme must be otpauth", ErrInvalidURI)
+	}
+	if u.Host != "totp" {
+		return nil, fmt.Errorf("%w: only totp is supported", ErrInvalidURI)
+	}
+
+	key := &Key{Algorithm: AlgorithmSHA1, Digits: DefaultDigits, Period: DefaultPeriod}
+	label := strings.TrimPrefix(u.Path, "/")
+	if issuer, account, found := strings.Cut(label, ":"); found {
+		key.Issuer, key.Account = strings.TrimSpace(issuer), strings.TrimSpace(account)
+	} else {
+		key.Account = strings.TrimSpace(label)
+	}
+
+	query := u.Query()
+	if issuer := query.Get("issuer"); issuer != "" {
+		key.Issuer = issuer
+	}
+	secret := strings.ToUpper(strings.ReplaceAll(query.Get("secret"), " ", ""))
+	key.Secret, err = encoding.DecodeString(strings.TrimRight(secret, "="))
+	if err != nil || len(key.Secret) == 0 {
+		return nil, fmt.Errorf("%w: secret must be non-empty base32", ErrInvalidURI)
+	}
+	if algorithm := query.Get("algorithm"); algorithm != "" {
+		key.Algorithm = strings.ToUpper(algorithm)
+		if newHash(key.Algorithm) == nil {
+			return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidURI, algorithm)
+		}
+	}
+	if digits := query.Get("digits"); digits != "" {
+		key.Digits, err = strconv.Atoi(digits)
+		if err != nil || key.Digits < 6 || key.Digits > 8 {
+			return nil, fmt.Errorf("%w: digits must be 6, 7 or 8", ErrInvalidURI)
+		}
+	}
+	if period := query.Get("period"); period != "" {
+		seconds, err := strconv.Atoi(period)
+		if err != nil || seconds <= 0 || seconds > 300 {
+			return nil, fmt.Errorf("%w: period must be 1 to 300 seconds", ErrInvalidURI)
+		}
+		key.Period = time.Duration(seconds) * time.Second
+	}
+	return key, nil
+}
+
+// Generate returns a key with a random secret and default parameters.
+func Generate(issuer, account string) (*Key, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return &Key{
+		Issuer:    issuer,
+		Account:   account,
+		Secret:    secret,
+		Algorithm: AlgorithmSHA1,
+		Digits:    DefaultDigits,
+		Period:    DefaultPeriod,
+	}, nil
+}
+
+// EncodedSecret returns the secret in base32 for manual entry into authenticator apps.
+func (k *Key) EncodedSecret() string {
+	return encoding.EncodeToString(k.Secret)
+}
+
+// URI returns the key in the otpauth://totp format, parameters with default values are omitted.
+func (k *Key) URI() string {
+	label := k.Account
+	if k.Issuer != "" {
+		label = k.Issuer + ":" + k.Account
+	}
+	query := url.Values{}
+	query.Set("secret", k.EncodedSecret())
+	if k.Issuer != "" {
+		query.Set("issuer", k.Issuer)
+	}
+	if k.Algorithm != AlgorithmSHA1 {
+		query.Set("algorithm", k.Algorithm)
+	}
+	if k.Digits != DefaultDigits {
+		query.Set("digits", strconv.Itoa(k.Digits))
+	}
+	if k.Period != DefaultPeriod {
+		query.Set("period", strconv.Itoa(int(k.Period/time.Second)))
+	}
+	u := url.URL{Scheme: "otpauth", Host: "totp", Path: "/" + label, RawQuery: query.Encode()}
+	return u.String()
+}
+
+// Code returns the RFC 6238 code valid at t and the time left until it changes.
+func (k *Key) Code(t time.Time) (string, time.Duration) {
+	counter := uint64(t.Unix()) / uint64(k.Period/time.Second)
+	next := time.Unix(int64((counter+1)*uint64(k.Period/time.Second)), 0)
+	return k.hotp(counter), next.Sub(t)
+}
+
+// Validate reports whether code is valid at t or up to skew periods around it,
+// and returns the counter it matched, so callers can reject a replayed code.
+func (k *Key) Validate(code string, t time.Time, skew int) (uint64, bool) {
+	if len(code) != k.Digits {
+		return 0, false
+	}
+	counter := t.Unix() / int64(k.Period/time.Second)
+	for i := -int64(skew); i <= int64(skew); i++ {
+		c := counter + i
+		if c < 0 {
+			continue
+		}
+		if hmac.Equal([]byte(k.hotp(uint64(c))), []byte(code)) {
+			return uint64(c), true
+		}
+	}
+	return 0, false
+}
+
+// hotp is the RFC 4226 code for counter.
+func (k *Key) hotp(counter uint64) string {
+	mac := hmac.New(func() hash.Hash { return newHash(k.Algorithm) }, k.Secret)
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < k.Digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", k.Digits, value%mod)
+}
+
+func newHash(algorithm string) hash.Hash {
+	switch algorithm {
+	case AlgorithmSHA1:
+		return sha1.New()
+	case AlgorithmSHA256:
+		return sha256.New()
+	case AlgorithmSHA512:
+		return sha512.New()
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package totp
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcKey returns a key with the seed of the RFC 6238 appendix B test vectors for algorithm.
+func rfcKey(algorithm string) *Key {
+	seed := map[string]string{
+		AlgorithmSHA1:   "12345678901234567890",
+		AlgorithmSHA256: "12345678901234567890123456789012",
+		AlgorithmSHA512: strings.Repeat("1234567890", 6) + "1234",
+	}[algorithm]
+	return &Key{Secret: []byte(seed), Algorithm: algorithm, Digits: 8, Period: DefaultPeriod}
+}
+
+func TestCodeRFC6238(t *testing.T) {
+	tests := []struct {
+		unix      int64
+		algorithm string
+		want      string
+	}{
+		{unix: 59, algorithm: AlgorithmSHA1, want: "94287082"},
+		{unix: 59, algorithm: AlgorithmSHA256, want: "46119246"},
+		{unix: 59, algorithm: AlgorithmSHA512, want: "90693936"},
+		{unix: 1111111109, algorithm: AlgorithmSHA1, want: "07081804"},
+		{unix: 1111111109, algorithm: AlgorithmSHA256, want: "68084774"},
+		{unix: 1111111109, algorithm: AlgorithmSHA512, want: "25091201"},
+		{unix: 1111111111, algorithm: AlgorithmSHA1, want: "14050471"},
+		{unix: 1111111111, algorithm: AlgorithmSHA256, want: "67062674"},
+		{unix: 1111111111, algorithm: AlgorithmSHA512, want: "99943326"},
+		{unix: 1234567890, algorithm: AlgorithmSHA1, want: "89005924"},
+		{unix: 1234567890, algorithm: AlgorithmSHA256, want: "91819424"},
+		{unix: 1234567890, algorithm: AlgorithmSHA512, want: "93441116"},
+		{unix: 2000000000, algorithm: AlgorithmSHA1, want: "69279037"},
+		{unix: 2000000000, algorithm: AlgorithmSHA256, want: "90698825"},
+		{unix: 2000000000, algorithm: AlgorithmSHA512, want: "38618901"},
+		{unix: 20000000000, algorithm: AlgorithmSHA1, want: "65353130"},
+		{unix: 20000000000, algorithm: AlgorithmSHA256, want: "77737706"},
+		{unix: 20000000000, algorithm: AlgorithmSHA512, want: "47863826"},
+	}
+	for _, tt := range tests {
+		got, left := rfcKey(tt.algorithm).Code(time.Unix(tt.unix, 0))
+		if got != tt.want {
+			t.Errorf("%s Code(%d) = %s, want %s", tt.algorithm, tt.unix, got, tt.want)
+		}
+		if want := time.Duration(30-tt.unix%30) * time.Second; left != want {
+			t.Errorf("%s Code(%d) time left = %v, want %v", tt.algorithm, tt.unix, left, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	key := rfcKey(AlgorithmSHA1)
+	now := time.Unix(1111111111, 0)
+	counter := uint64(1111111111 / 30)
+	code := func(offset time.Duration) string {
+		c, _ := key.Code(now.Add(offset))
+		return c
+	}
+	tests := []struct {
+		name        string
+		code        string
+		skew        int
+		want        bool
+		wantCounter uint64
+	}{
+		{name: "current", code: code(0), want: true, wantCounter: counter},
+		{name: "previous period within skew", code: code(-30 * time.Second), skew: 1, want: true, wantCounter: counter - 1},
+		{name: "next period within skew", code: code(30 * time.Second), skew: 1, want: true, wantCounter: counter + 1},
+		{name: "previous period without skew", code: code(-30 * time.Second)},
+		{name: "outside skew", code: code(-90 * time.Second), skew: 1},
+		{name: "wrong code", code: "00000000", skew: 1},
+		{name: "wrong length", code: code(0)[:6], skew: 1},
+		{name: "empty", code: "", skew: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := key.Validate(tt.code, now, tt.skew)
+			if ok != tt.want {
+				t.Fatalf("Validate(%q) = %v, want %v", tt.code, ok, tt.want)
+			}
+			if ok && got != tt.wantCounter {
+				t.Errorf("Validate(%q) counter = %d, want %d", tt.code, got, tt.wantCounter)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     string
+		want    Key
+		wantErr bool
+	}{
+		{name: "defaults", uri: "otpauth://totp/alice@example.com?secret=JBSWY3DPEHPK3PXP",
+			want: Key{Account: "alice@example.com", Algorithm: AlgorithmSHA1, Digits: 6, Period: 30 * time.Second}},
+		{name: "issuer in label", uri: "otpauth://totp/ACME%20Co:john.doe@email.com?secret=HXDMVJECJJWSRB3HWIZR4IFUGFTMXBOZ",
+			want: Key{Issuer: "ACME Co", Account: "john.doe@email.com", Algorithm: AlgorithmSHA1, Digits: 6, Period: 30 * time.Second}},
+		{name: "all parameters", uri: "otpauth://totp/ACME:john?secret=jbswy3dpehpk3pxp&issuer=Other&algorithm=sha256&digits=8&period=60",
+			want: Key{Issuer: "Other", Account: "john", Algorithm: AlgorithmSHA256, Digits: 8, Period: time.Minute}},
+		{name: "hotp", uri: "otpauth://hotp/john?secret=JBSWY3DPEHPK3PXP&counter=1", wantErr: true},
+		{name: "other scheme", uri: "https://totp/john?secret=JBSWY3DPEHPK3PXP", wantErr: true},
+		{name: "missing secret", uri: "otpauth://totp/john", wantErr: true},
+		{name: "secret not base32", uri: "otpauth://totp/john?secret=not-base32!", wantErr: true},
+		{name: "unknown algorithm", uri: "otpauth://totp/john?secret=JBSWY3DPEHPK3PXP&algorithm=MD5", wantErr: true},
+		{name: "too few digits", uri: "otpauth://totp/john?secret=JBSWY3DPEHPK3PXP&digits=4", wantErr: true},
+		{name: "zero period", uri: "otpauth://totp/john?secret=JBSWY3DPEHPK3PXP&period=0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.uri)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrInvalidURI) {
+					t.Errorf("Parse() error = %v, want %v", err, ErrInvalidURI)
+				}
+				return
+			}
+			if got.Issuer != tt.want.Issuer || got.Account != tt.want.Account || got.Algorithm != tt.want.Algorithm ||
+				got.Digits != tt.want.Digits || got.Period != tt.want.Period || len(got.Secret) == 0 {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURIRoundTrip(t *testing.T) {
+	generated, err := Generate("Password Manager", "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(generated.Secret) != secretSize {
+		t.Errorf("generated secret has %d bytes, want %d", len(generated.Secret), secretSize)
+	}
+	custom := rfcKey(AlgorithmSHA512)
+	custom.Issuer, custom.Account, custom.Period = "ACME", "bob", time.Minute
+
+	for _, key := range []*Key{generated, custom} {
+		parsed, err := Parse(key.URI())
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", key.URI(), err)
+		}
+		if parsed.URI() != key.URI() {
+			t.Errorf("Parse(%q).URI() = %q", key.URI(), parsed.URI())
+		}
+		now := time.Now()
+		want, _ := key.Code(now)
+		if got, _ := parsed.Code(now); got != want {
+			t.Errorf("parsed key of %q generates %s, want %s", key.URI(), got, want)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"math/big"
+)
+
+// COSE algorithm identifiers of the supported credential keys.
+const (
+	AlgES256 = -7
+	AlgEdDSA = -8
+	AlgRS256 = -257
+)
+
+const (
+	coseKeyTypeOKP = 1
+	coseKeyTypeEC2 = 2
+	coseKeyTypeRSA = 3
+
+	coseCurveP256    = 1
+	coseCurveEd25519 = 6
+
+	minRSABits = 2048
+)
+
+// coseKey holds parameters of a COSE_Key, their meaning depends on the key type:
+// -1 is the curve or the RSA modulus, -2 is x or the RSA exponent, -3 is y.
+type coseKey struct {
+	Kty int64           `cbor:"1,keyasint"`
+	Alg int64           `cbor:"3,keyasint"`
+	P1  cbor.RawMessage `cbor:"-1,keyasint"`
+	P2  []byte          `cbor:"-2,keyasint"`
+	P3  []byte          `cbor:"-3,keyasint"`
+}
+
+// publicKey is a credential public key with the algorithm it signs with.
+type publicKey struct {
+	alg int64
+	key crypto.PublicKey
+}
+
+func parsePublicKey(data []byte) (*publicKey, error) {
+	var k coseKey
+	if err := cbor.Unmarshal(data, &k); err != nil {
+		return nil, fmt.Errorf("%w: malformed public key", ErrInvalidResponse)
+	}
+
+	switch {
+	case k.Kty == coseKeyTypeEC2 && k.Alg == AlgES256:
+		var crv int64
+		if err := cbor.Unmarshal(k.P1, &crv); err != nil || crv != coseCurveP256 {
+			return nil, fmt.Errorf("%w: ES256 key must use P-256", ErrUnsupported)
+		}
+		if len(k.P2) != 32 || len(k.P3) != 32 {
+			return nil, fmt.Errorf("%w: malformed P-256 key", ErrInvalidResponse)
+		}
+		point := append(append([]byte{4}, k.P2...), k.P3...)
+		// ecdh rejects points which are not on the curve.
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return nil, fmt.Errorf("%w: invalid P-256 point", ErrInvalidResponse)
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(k.P2), Y: new(big.Int).SetBytes(k.P3)}
+		return &publicKey{alg: AlgES256, key: key}, nil
+	case k.Kty == coseKeyTypeOKP && k.Alg == AlgEdDSA:
+		var crv int64
+		if err := cbor.Unmarshal(k.P1, &crv); err != nil || crv != coseCurveEd25519 {
+			return nil, fmt.Errorf("%w: EdDSA key must use Ed25519", ErrUnsupported)
+		}
+		if len(k.P2) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("%w: malformed Ed25519 key", ErrInvalidResponse)
+		}
+		return &publicKey{alg: AlgEdDSA, key: ed25519.PublicKey(k.P2)}, nil
+	case k.Kty == coseKeyTypeRSA && k.Alg == AlgRS256:
+		var n []byte
+		if err := cbor.Unmarshal(k.P1, &n); err != nil || len(n)*8 < minRSABits {
+			return nil, fmt.Errorf("%w: RSA key must be at least %d bits", ErrUnsupported, minRSABits)
+		}
+		e := new(big.Int).SetBytes(k.P2)
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("%w: malformed RSA key", ErrInvalidResponse)
+		}
+		key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(e.Int64())}
+		return &publicKey{alg: AlgRS256, key: key}, nil
+	}
+	return nil, fmt.Errorf("%w: key type %d with algorithm %d", ErrUnsupported, k.Kty, k.Alg)
+}
+
+// verify checks the signature of data made with the key.
+func (k *publicKey) verify(data, sig []byte) bool {
+	return verifySignature(k.alg, k.key, data, sig)
+}
+
+func verifySignature(alg int64, key crypto.PublicKey, data, sig []byte) bool {
+	digest := sha256.Sum256(data)
+	switch alg {
+	case AlgES256:
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		return ok && ecdsa.VerifyASN1(ecKey, digest[:], sig)
+	case AlgEdDSA:
+		edKey, ok := key.(ed25519.PublicKey)
+		return ok && ed25519.Verify(edKey, data, sig)
+	case AlgRS256:
+		rsaKey, ok := key.(*rsa.PublicKey)
+		return ok && rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest[:], sig) == nil
+	}
+	return false
+}
+
+// verifyCertificate checks the signature of data made with the key of an attestation certificate.
+func verifyCertificate(alg int64, der []byte, data, sig []byte) bool {
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return false
+	}
+	return verifySignature(alg, cert.PublicKey, data, sig)
+}
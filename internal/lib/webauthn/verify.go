@@ -0,0 +1,220 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"github.com/fxamacker/cbor/v2"
+	"slices"
+	"strings"
+)
+
+const (
+	clientDataCreate = "webauthn.create"
+	clientDataGet    = "webauthn.get"
+
+	attestationPacked = "packed"
+
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagAttestedCredentialData = 0x40
+	flagExtensionData          = 0x80
+
+	// authenticatorDataSize is the size of rpIdHash, flags and signCount.
+	authenticatorDataSize = 37
+	aaguidSize            = 16
+	maxCredentialIDSize   = 1023
+)
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	aaguid       []byte
+	credentialID []byte
+	publicKey    []byte
+}
+
+type attestationObject struct {
+	Format   string          `cbor:"fmt"`
+	AttStmt  cbor.RawMessage `cbor:"attStmt"`
+	AuthData []byte          `cbor:"authData"`
+}
+
+type packedStatement struct {
+	Alg int64    `cbor:"alg"`
+	Sig []byte   `cbor:"sig"`
+	X5C [][]byte `cbor:"x5c"`
+}
+
+// VerifyRegistration checks the response of navigator.credentials.create to the challenge
+// and returns the new credential. Attestation is accepted in the none and packed formats,
+// attestation certificates aren't checked against a trust store, so they only prove
+// the authenticator holds the key.
+func (c Config) VerifyRegistration(challenge, clientDataJSON, attestation []byte) (*Credential, error) {
+	if err := c.verifyClientData(clientDataJSON, clientDataCreate, challenge); err != nil {
+		return nil, err
+	}
+
+	var object attestationObject
+	if err := cbor.Unmarshal(attestation, &object); err != nil {
+		return nil, fmt.Errorf("%w: malformed attestation object", ErrInvalidResponse)
+	}
+	data, err := c.parseAuthenticatorData(object.AuthData)
+	if err != nil {
+		return nil, err
+	}
+	if data.flags&flagAttestedCredentialData == 0 {
+		return nil, fmt.Errorf("%w: attested credential data is missing", ErrInvalidResponse)
+	}
+	key, err := parsePublicKey(data.publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(bytes.Clone(object.AuthData), clientDataHash[:]...)
+	switch object.Format {
+	case attestationNone:
+		var statement map[string]interface{}
+		if err := cbor.Unmarshal(object.AttStmt, &statement); err != nil || len(statement) != 0 {
+			return nil, fmt.Errorf("%w: none attestation must have an empty statement", ErrInvalidResponse)
+		}
+	case attestationPacked:
+		var statement packedStatement
+		if err := cbor.Unmarshal(object.AttStmt, &statement); err != nil {
+			return nil, fmt.Errorf("%w: malformed packed attestation", ErrInvalidResponse)
+		}
+		var valid bool
+		if len(statement.X5C) > 0 {
+			valid = verifyCertificate(statement.Alg, statement.X5C[0], signed, statement.Sig)
+		} else {
+			valid = statement.Alg == key.alg && key.verify(signed, statement.Sig)
+		}
+		if !valid {
+			return nil, fmt.Errorf("%w: invalid attestation signature", ErrInvalidResponse)
+		}
+	default:
+		return nil, fmt.Errorf("%w: attestation format %q", ErrUnsupported, object.Format)
+	}
+
+	return &Credential{
+		ID:          data.credentialID,
+		PublicKey:   data.publicKey,
+		SignCount:   data.signCount,
+		AAGUID:      data.aaguid,
+		Attestation: object.Format,
+	}, nil
+}
+
+// VerifyAssertion checks the response of navigator.credentials.get to the challenge made
+// with the credential and returns the new signature counter to store.
+// It returns ErrSignCount if the counter didn't increase.
+func (c Config) VerifyAssertion(challenge []byte, credential Credential, clientDataJSON, authData, signature []byte) (uint32, error) {
+	if err := c.verifyClientData(clientDataJSON, clientDataGet, challenge); err != nil {
+		return 0, err
+	}
+	data, err := c.parseAuthenticatorData(authData)
+	if err != nil {
+		return 0, err
+	}
+	key, err := parsePublicKey(credential.PublicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	if !key.verify(append(bytes.Clone(authData), clientDataHash[:]...), signature) {
+		return 0, fmt.Errorf("%w: invalid signature", ErrInvalidResponse)
+	}
+
+	// Authenticators without a counter always report zero.
+	if (data.signCount != 0 || credential.SignCount != 0) && data.signCount <= credential.SignCount {
+		return 0, ErrSignCount
+	}
+	return data.signCount, nil
+}
+
+func (c Config) verifyClientData(raw []byte, ceremony string, challenge []byte) error {
+	var data clientData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return fmt.Errorf("%w: malformed client data", ErrInvalidResponse)
+	}
+	if data.Type != ceremony {
+		return fmt.Errorf("%w: client data type must be %s", ErrInvalidResponse, ceremony)
+	}
+	got, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(data.Challenge, "="))
+	if err != nil || subtle.ConstantTimeCompare(got, challenge) != 1 {
+		return fmt.Errorf("%w: challenge mismatch", ErrInvalidResponse)
+	}
+	if !slices.Contains(c.Origins, data.Origin) {
+		return fmt.Errorf("%w: origin %q is not allowed", ErrInvalidResponse, data.Origin)
+	}
+	return nil
+}
+
+func (c Config) parseAuthenticatorData(raw []byte) (*authenticatorData, error) {
+	if len(raw) < authenticatorDataSize {
+		return nil, fmt.Errorf("%w: authenticator data is too short", ErrInvalidResponse)
+	}
+	data := &authenticatorData{
+		rpIDHash:  raw[:32],
+		flags:     raw[32],
+		signCount: binary.BigEndian.Uint32(raw[33:37]),
+	}
+
+	rpIDHash := sha256.Sum256([]byte(c.RPID))
+	if subtle.ConstantTimeCompare(data.rpIDHash, rpIDHash[:]) != 1 {
+		return nil, fmt.Errorf("%w: rp id mismatch", ErrInvalidResponse)
+	}
+	if data.flags&flagUserPresent == 0 {
+		return nil, fmt.Errorf("%w: user is not present", ErrInvalidResponse)
+	}
+	if c.UserVerification && data.flags&flagUserVerified == 0 {
+		return nil, fmt.Errorf("%w: user is not verified", ErrInvalidResponse)
+	}
+
+	rest := raw[authenticatorDataSize:]
+	if data.flags&flagAttestedCredentialData != 0 {
+		if len(rest) < aaguidSize+2 {
+			return nil, fmt.Errorf("%w: attested credential data is too short", ErrInvalidResponse)
+		}
+		data.aaguid = rest[:aaguidSize]
+		size := int(binary.BigEndian.Uint16(rest[aaguidSize:]))
+		rest = rest[aaguidSize+2:]
+		if size == 0 || size > maxCredentialIDSize || len(rest) < size {
+			return nil, fmt.Errorf("%w: malformed credential id", ErrInvalidResponse)
+		}
+		data.credentialID = rest[:size]
+
+		var key cbor.RawMessage
+		var err error
+		rest, err = cbor.UnmarshalFirst(rest[size:], &key)
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed credential public key", ErrInvalidResponse)
+		}
+		data.publicKey = key
+	}
+	if data.flags&flagExtensionData != 0 {
+		var extensions map[string]interface{}
+		var err error
+		rest, err = cbor.UnmarshalFirst(rest, &extensions)
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed extensions", ErrInvalidResponse)
+		}
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: trailing authenticator data", ErrInvalidResponse)
+	}
+	return data, nil
+}
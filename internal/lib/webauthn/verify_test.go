@@ -0,0 +1,313 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"github.com/fxamacker/cbor/v2"
+	"testing"
+)
+
+const (
+	testRPID   = "vault.example.com"
+	testOrigin = "https://vault.example.com"
+)
+
+var testConfig = Config{RPID: testRPID, RPName: "Vault", Origins: []string{testOrigin}}
+
+// softAuthenticator is a software security key holding one credential.
+type softAuthenticator struct {
+	alg          int64
+	ecKey        *ecdsa.PrivateKey
+	edKey        ed25519.PrivateKey
+	credentialID []byte
+	publicKey    []byte
+	signCount    uint32
+}
+
+func newAuthenticator(t *testing.T, alg int64) *softAuthenticator {
+	t.Helper()
+	a := &softAuthenticator{alg: alg, credentialID: make([]byte, 16)}
+	if _, err := rand.Read(a.credentialID); err != nil {
+		t.Fatal(err)
+	}
+	var err error
+	switch alg {
+	case AlgES256:
+		a.ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgEdDSA:
+		_, a.edKey, err = ed25519.GenerateKey(rand.Reader)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.publicKey = a.coseKey(t)
+	return a
+}
+
+// coseKey encodes the public key, map order isn't deterministic, so it's encoded once.
+func (a *softAuthenticator) coseKey(t *testing.T) []byte {
+	t.Helper()
+	var key map[int]interface{}
+	switch a.alg {
+	case AlgES256:
+		x, y := make([]byte, 32), make([]byte, 32)
+		a.ecKey.X.FillBytes(x)
+		a.ecKey.Y.FillBytes(y)
+		key = map[int]interface{}{1: coseKeyTypeEC2, 3: AlgES256, -1: coseCurveP256, -2: x, -3: y}
+	case AlgEdDSA:
+		key = map[int]interface{}{1: coseKeyTypeOKP, 3: AlgEdDSA, -1: coseCurveEd25519, -2: []byte(a.edKey.Public().(ed25519.PublicKey))}
+	}
+	encoded, err := cbor.Marshal(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encoded
+}
+
+func (a *softAuthenticator) sign(t *testing.T, authData, clientDataJSON []byte) []byte {
+	t.Helper()
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(bytes.Clone(authData), clientDataHash[:]...)
+	if a.alg == AlgEdDSA {
+		return ed25519.Sign(a.edKey, signed)
+	}
+	digest := sha256.Sum256(signed)
+	sig, err := ecdsa.SignASN1(rand.Reader, a.ecKey, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+// authData builds authenticator data, attested credential data is added for registration.
+func (a *softAuthenticator) authData(t *testing.T, rpID string, flags byte, attested bool) []byte {
+	t.Helper()
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append(rpIDHash[:], flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+	if attested {
+		data = append(data, make([]byte, aaguidSize)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+		data = append(data, a.credentialID...)
+		data = append(data, a.publicKey...)
+	}
+	return data
+}
+
+func clientDataJSON(t *testing.T, ceremony string, challenge []byte, origin string) []byte {
+	t.Helper()
+	data, err := json.Marshal(clientData{Type: ceremony, Challenge: encode(challenge), Origin: origin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// ceremony lists what the authenticator and the browser put into a response.
+type ceremony struct {
+	rpID      string
+	origin    string
+	ceremony  string
+	flags     byte
+	challenge []byte
+}
+
+func (a *softAuthenticator) register(t *testing.T, c ceremony, format string) (clientData, attestation []byte) {
+	t.Helper()
+	clientData = clientDataJSON(t, c.ceremony, c.challenge, c.origin)
+	authData := a.authData(t, c.rpID, c.flags|flagAttestedCredentialData, true)
+	statement := map[string]interface{}{}
+	if format == attestationPacked {
+		statement = map[string]interface{}{"alg": a.alg, "sig": a.sign(t, authData, clientData)}
+	}
+	attestation, err := cbor.Marshal(map[string]interface{}{"fmt": format, "attStmt": statement, "authData": authData})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clientData, attestation
+}
+
+func (a *softAuthenticator) assert(t *testing.T, c ceremony) (clientData, authData, signature []byte) {
+	t.Helper()
+	clientData = clientDataJSON(t, c.ceremony, c.challenge, c.origin)
+	authData = a.authData(t, c.rpID, c.flags, false)
+	return clientData, authData, a.sign(t, authData, clientData)
+}
+
+var algorithms = []struct {
+	name string
+	alg  int64
+}{
+	{name: "ES256", alg: AlgES256},
+	{name: "Ed25519", alg: AlgEdDSA},
+}
+
+func TestVerifyRegistration(t *testing.T) {
+	challenge := []byte("registration challenge")
+	valid := ceremony{rpID: testRPID, origin: testOrigin, ceremony: clientDataCreate, flags: flagUserPresent, challenge: challenge}
+	tests := []struct {
+		name    string
+		config  Config
+		modify  func(c *ceremony)
+		format  string
+		wantErr error
+	}{
+		{name: "none attestation", format: attestationNone},
+		{name: "packed self attestation", format: attestationPacked},
+		{name: "wrong origin", format: attestationNone, wantErr: ErrInvalidResponse,
+			modify: func(c *ceremony) { c.origin = "https://evil.example.com" }},
+		{name: "wrong rp id", format: attestationNone, wantErr: ErrInvalidResponse,
+			modify: func(c *ceremony) { c.rpID = "evil.example.com" }},
+		{name: "user not present", format: attestationNone, wantErr: ErrInvalidResponse,
+			modify: func(c *ceremony) { c.flags = 0 }},
+		{name: "user not verified", format: attestationNone, wantErr: ErrInvalidResponse,
+			config: Config{RPID: testRPID, Origins: []string{testOrigin}, UserVerification: true}},
+		{name: "other challenge", format: attestationNone, wantErr: ErrInvalidResponse,
+			modify: func(c *ceremony) { c.challenge = []byte("other challenge") }},
+		{name: "assertion client data", format: attestationNone, wantErr: ErrInvalidResponse,
+			modify: func(c *ceremony) { c.ceremony = clientDataGet }},
+		{name: "unknown format", format: "tpm", wantErr: ErrUnsupported},
+	}
+	for _, alg := range algorithms {
+		for _, tt := range tests {
+			t.Run(alg.name+"/"+tt.name, func(t *testing.T) {
+				config := testConfig
+				if tt.config.RPID != "" {
+					config = tt.config
+				}
+				c := valid
+				if tt.modify != nil {
+					tt.modify(&c)
+				}
+				a := newAuthenticator(t, alg.alg)
+				clientData, attestation := a.register(t, c, tt.format)
+
+				credential, err := config.VerifyRegistration(challenge, clientData, attestation)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("VerifyRegistration() error = %v, want %v", err, tt.wantErr)
+				}
+				if err != nil {
+					return
+				}
+				if !bytes.Equal(credential.ID, a.credentialID) {
+					t.Errorf("credential id = %x, want %x", credential.ID, a.credentialID)
+				}
+				if !bytes.Equal(credential.PublicKey, a.publicKey) {
+					t.Error("credential public key doesn't match the authenticator key")
+				}
+				if credential.Attestation != tt.format {
+					t.Errorf("attestation = %q, want %q", credential.Attestation, tt.format)
+				}
+			})
+		}
+	}
+}
+
+func TestVerifyRegistrationRejectsForgedPackedSignature(t *testing.T) {
+	challenge := []byte("registration challenge")
+	for _, alg := range algorithms {
+		t.Run(alg.name, func(t *testing.T) {
+			a := newAuthenticator(t, alg.alg)
+			c := ceremony{rpID: testRPID, origin: testOrigin, ceremony: clientDataCreate, flags: flagUserPresent, challenge: challenge}
+			clientData, _ := a.register(t, c, attestationPacked)
+			authData := a.authData(t, testRPID, flagUserPresent|flagAttestedCredentialData, true)
+			// The statement is signed over client data of another ceremony.
+			forged := a.sign(t, authData, clientDataJSON(t, clientDataCreate, []byte("other"), testOrigin))
+			attestation, err := cbor.Marshal(map[string]interface{}{
+				"fmt":      attestationPacked,
+				"attStmt":  map[string]interface{}{"alg": a.alg, "sig": forged},
+				"authData": authData,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := testConfig.VerifyRegistration(challenge, clientData, attestation); !errors.Is(err, ErrInvalidResponse) {
+				t.Errorf("VerifyRegistration() error = %v, want %v", err, ErrInvalidResponse)
+			}
+		})
+	}
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	challenge := []byte("assertion challenge")
+	valid := ceremony{rpID: testRPID, origin: testOrigin, ceremony: clientDataGet, flags: flagUserPresent, challenge: challenge}
+	tests := []struct {
+		name      string
+		stored    uint32
+		signCount uint32
+		modify    func(c *ceremony)
+		wantErr   error
+	}{
+		{name: "counter increased", stored: 5, signCount: 6},
+		{name: "no counter", stored: 0, signCount: 0},
+		{name: "first use", stored: 0, signCount: 1},
+		{name: "counter repeated", stored: 5, signCount: 5, wantErr: ErrSignCount},
+		{name: "counter regressed", stored: 5, signCount: 2, wantErr: ErrSignCount},
+		{name: "counter reset to zero", stored: 5, signCount: 0, wantErr: ErrSignCount},
+		{name: "wrong origin", stored: 5, signCount: 6, wantErr: ErrInvalidResponse,
+			modify: func(c *ceremony) { c.origin = "https://vault.example.com.evil.net" }},
+		{name: "wrong rp id", stored: 5, signCount: 6, wantErr: ErrInvalidResponse,
+			modify: func(c *ceremony) { c.rpID = "example.com" }},
+		{name: "user not present", stored: 5, signCount: 6, wantErr: ErrInvalidResponse,
+			modify: func(c *ceremony) { c.flags = flagUserVerified }},
+		{name: "other challenge", stored: 5, signCount: 6, wantErr: ErrInvalidResponse,
+			modify: func(c *ceremony) { c.challenge = []byte("replayed challenge") }},
+		{name: "registration client data", stored: 5, signCount: 6, wantErr: ErrInvalidResponse,
+			modify: func(c *ceremony) { c.ceremony = clientDataCreate }},
+	}
+	for _, alg := range algorithms {
+		for _, tt := range tests {
+			t.Run(alg.name+"/"+tt.name, func(t *testing.T) {
+				a := newAuthenticator(t, alg.alg)
+				credential := Credential{ID: a.credentialID, PublicKey: a.publicKey, SignCount: tt.stored}
+				c := valid
+				if tt.modify != nil {
+					tt.modify(&c)
+				}
+				a.signCount = tt.signCount
+				clientData, authData, signature := a.assert(t, c)
+
+				got, err := testConfig.VerifyAssertion(challenge, credential, clientData, authData, signature)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("VerifyAssertion() error = %v, want %v", err, tt.wantErr)
+				}
+				if err == nil && got != tt.signCount {
+					t.Errorf("VerifyAssertion() = %d, want %d", got, tt.signCount)
+				}
+			})
+		}
+	}
+}
+
+func TestVerifyAssertionRejectsBadSignature(t *testing.T) {
+	challenge := []byte("assertion challenge")
+	c := ceremony{rpID: testRPID, origin: testOrigin, ceremony: clientDataGet, flags: flagUserPresent, challenge: challenge}
+	for _, alg := range algorithms {
+		t.Run(alg.name, func(t *testing.T) {
+			a := newAuthenticator(t, alg.alg)
+			credential := Credential{ID: a.credentialID, PublicKey: a.publicKey}
+			a.signCount = 1
+
+			other := newAuthenticator(t, alg.alg)
+			other.signCount = 1
+			clientData, authData, signature := other.assert(t, c)
+			if _, err := testConfig.VerifyAssertion(challenge, credential, clientData, authData, signature); !errors.Is(err, ErrInvalidResponse) {
+				t.Errorf("signature of another key: error = %v, want %v", err, ErrInvalidResponse)
+			}
+
+			clientData, authData, signature = a.assert(t, c)
+			tampered := bytes.Clone(authData)
+			binary.BigEndian.PutUint32(tampered[33:], 100)
+			if _, err := testConfig.VerifyAssertion(challenge, credential, clientData, tampered, signature); !errors.Is(err, ErrInvalidResponse) {
+				t.Errorf("tampered counter: error = %v, want %v", err, ErrInvalidResponse)
+			}
+		})
+	}
+}
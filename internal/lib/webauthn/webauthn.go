@@ -0,0 +1,159 @@
+// Package webauthn implements the relying party side of WebAuthn registration
+// and authentication ceremonies for security keys used as a second factor.
+package webauthn
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	challengeSize = 32
+
+	attestationNone = "none"
+)
+
+var (
+	ErrInvalidResponse = errors.New("invalid webauthn response")
+	ErrUnsupported     = errors.New("unsupported webauthn authenticator")
+	// ErrSignCount means the signature counter didn't increase, the authenticator may have been cloned.
+	ErrSignCount = errors.New("webauthn signature counter did not increase")
+)
+
+// Config describes the relying party. RPID is the domain credentials are scoped to,
+// Origins are the web origins allowed to run ceremonies, e.g. https://vault.example.com.
+// UserVerification additionally requires a PIN or biometrics on the authenticator.
+type Config struct {
+	RPID             string
+	RPName           string
+	Origins          []string
+	UserVerification bool
+	Timeout          time.Duration
+}
+
+// Credential is a registered public key credential, PublicKey is a COSE_Key.
+type Credential struct {
+	ID          []byte
+	PublicKey   []byte
+	SignCount   uint32
+	AAGUID      []byte
+	Attestation string
+}
+
+// Enabled reports whether the relying party is configured.
+func (c Config) Enabled() bool {
+	return c.RPID != "" && len(c.Origins) > 0
+}
+
+// NewChallenge returns a random challenge for a ceremony, it must be used once.
+func NewChallenge() ([]byte, error) {
+	challenge := make([]byte, challengeSize)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+type relyingParty struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type userEntity struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type credentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+type credentialDescriptor struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type authenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// creationOptions is PublicKeyCredentialCreationOptionsJSON, binary fields are base64url.
+type creationOptions struct {
+	Challenge              string                 `json:"challenge"`
+	RP                     relyingParty           `json:"rp"`
+	User                   userEntity             `json:"user"`
+	PubKeyCredParams       []credentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout,omitempty"`
+	ExcludeCredentials     []credentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection authenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+// requestOptions is PublicKeyCredentialRequestOptionsJSON, binary fields are base64url.
+type requestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	RPID             string                 `json:"rpId"`
+	Timeout          int64                  `json:"timeout,omitempty"`
+	AllowCredentials []credentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// CreationOptions returns JSON options for navigator.credentials.create.
+// Credentials in exclude are already registered and can't be registered again.
+func (c Config) CreationOptions(challenge []byte, userId []byte, name string, exclude [][]byte) ([]byte, error) {
+	options := creationOptions{
+		Challenge: encode(challenge),
+		RP:        relyingParty{ID: c.RPID, Name: c.RPName},
+		User:      userEntity{ID: encode(userId), Name: name, DisplayName: name},
+		PubKeyCredParams: []credentialParameter{
+			{Type: "public-key", Alg: AlgES256},
+			{Type: "public-key", Alg: AlgEdDSA},
+			{Type: "public-key", Alg: AlgRS256},
+		},
+		Timeout:            c.Timeout.Milliseconds(),
+		ExcludeCredentials: descriptors(exclude),
+		AuthenticatorSelection: authenticatorSelection{
+			ResidentKey:      "discouraged",
+			UserVerification: c.userVerification(),
+		},
+		Attestation: attestationNone,
+	}
+	return json.Marshal(options)
+}
+
+// RequestOptions returns JSON options for navigator.credentials.get limited to the allowed credentials.
+func (c Config) RequestOptions(challenge []byte, allow [][]byte) ([]byte, error) {
+	options := requestOptions{
+		Challenge:        encode(challenge),
+		RPID:             c.RPID,
+		Timeout:          c.Timeout.Milliseconds(),
+		AllowCredentials: descriptors(allow),
+		UserVerification: c.userVerification(),
+	}
+	return json.Marshal(options)
+}
+
+func (c Config) userVerification() string {
+	if c.UserVerification {
+		return "required"
+	}
+	return "discouraged"
+}
+
+func descriptors(ids [][]byte) []credentialDescriptor {
+	result := make([]credentialDescriptor, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, credentialDescriptor{Type: "public-key", ID: encode(id)})
+	}
+	return result
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
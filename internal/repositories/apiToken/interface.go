@@ -0,0 +1,16 @@
+package apiToken
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"time"
+)
+
+type Repository interface {
+	Create(ctx context.Context, token domain.APIToken, tokenHash []byte, ttl time.Duration) (domain.APIToken, error)
+	GetByHash(ctx context.Context, tokenHash []byte) (*domain.APIToken, error)
+	Touch(ctx context.Context, id uuid.UUID, interval time.Duration) error
+	List(ctx context.Context, userId uuid.UUID) ([]domain.APIToken, error)
+	Revoke(ctx context.Context, userId uuid.UUID, id uuid.UUID) error
+}
@@ -0,0 +1,148 @@
+package apiToken
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"strings"
+	"time"
+)
+
+type PostgresRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRepository(dataSourceName string) (*PostgresRepository, error) {
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Check the connection
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresRepository{db}, nil
+}
+
+// Create stores the token valid for ttl and drops expired tokens of the user.
+func (s *PostgresRepository) Create(ctx context.Context, token domain.APIToken, tokenHash []byte, ttl time.Duration) (domain.APIToken, error) {
+	const op = "repositories.apiToken.postgres.Create"
+
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM api_tokens WHERE user_id = $1 AND expires_at < now()", token.UserId); err != nil {
+		return domain.APIToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+	err := s.db.QueryRowContext(ctx,
+		`INSERT INTO api_tokens (user_id, name, token_hash, scopes, folder_id, expires_at)
+		VALUES ($1, $2, $3, $4, $5, now() + make_interval(secs => $6)) RETURNING id, created_at, expires_at`,
+		token.UserId, token.Name, tokenHash, strings.Join(token.Scopes, " "), token.FolderId, ttl.Seconds(),
+	).Scan(&token.ID, &token.CreatedAt, &token.ExpiresAt)
+	if err != nil {
+		return domain.APIToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return token, nil
+}
+
+// GetByHash returns the unexpired token with the hash.
+func (s *PostgresRepository) GetByHash(ctx context.Context, tokenHash []byte) (*domain.APIToken, error) {
+	const op = "repositories.apiToken.postgres.GetByHash"
+
+	row := s.db.QueryRowContext(ctx,
+		`SELECT id, user_id, name, scopes, folder_id, created_at, expires_at, last_used_at FROM api_tokens
+		WHERE token_hash = $1 AND expires_at > now()`, tokenHash)
+	token, err := scanToken(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrAPITokenNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &token, nil
+}
+
+// Touch records the token was used, at most once per interval to spare writes on every request.
+func (s *PostgresRepository) Touch(ctx context.Context, id uuid.UUID, interval time.Duration) error {
+	const op = "repositories.apiToken.postgres.Touch"
+
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE api_tokens SET last_used_at = now()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < now() - make_interval(secs => $2))`,
+		id, interval.Seconds())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// List returns tokens of the user including expired ones, the newest first.
+func (s *PostgresRepository) List(ctx context.Context, userId uuid.UUID) ([]domain.APIToken, error) {
+	const op = "repositories.apiToken.postgres.List"
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, user_id, name, scopes, folder_id, created_at, expires_at, last_used_at FROM api_tokens
+		WHERE user_id = $1 ORDER BY created_at DESC`, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var tokens []domain.APIToken
+	for rows.Next() {
+		token, err := scanToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return tokens, nil
+}
+
+// Revoke deletes the user's token, it's rejected from now on.
+func (s *PostgresRepository) Revoke(ctx context.Context, userId uuid.UUID, id uuid.UUID) error {
+	const op = "repositories.apiToken.postgres.Revoke"
+
+	res, err := s.db.ExecContext(ctx, "DELETE FROM api_tokens WHERE id = $1 AND user_id = $2", id, userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrAPITokenNotFound)
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanToken(row scanner) (domain.APIToken, error) {
+	var token domain.APIToken
+	var scopes string
+	var folderId uuid.NullUUID
+	var lastUsedAt sql.NullTime
+	err := row.Scan(&token.ID, &token.UserId, &token.Name, &scopes, &folderId,
+		&token.CreatedAt, &token.ExpiresAt, &lastUsedAt)
+	if err != nil {
+		return domain.APIToken{}, err
+	}
+	token.Scopes = strings.Fields(scopes)
+	if folderId.Valid {
+		token.FolderId = &folderId.UUID
+	}
+	if lastUsedAt.Valid {
+		token.LastUsedAt = &lastUsedAt.Time
+	}
+	return token, nil
+}
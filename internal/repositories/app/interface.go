@@ -7,4 +7,11 @@ import (
 
 type Repository interface {
 	App(ctx context.Context, appID int64) (domain.App, error)
+	Apps(ctx context.Context) ([]domain.App, error)
+	Create(ctx context.Context, name string, secretHash []byte) (domain.App, error)
+	UpdateSecret(ctx context.Context, appID int64, secretHash []byte) error
+	SetDisabled(ctx context.Context, appID int64, disabled bool) error
+	Delete(ctx context.Context, appID int64) error
+	RedirectURIs(ctx context.Context, appID int64) ([]string, error)
+	SetRedirectURIs(ctx context.Context, appID int64, uris []string) error
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/jackc/pgconn"
 	"github.com/s0vunia/password-manager/internal/domain"
 	"github.com/s0vunia/password-manager/internal/repositories"
 )
@@ -13,6 +14,12 @@ type PostgresRepository struct {
 	db *sql.DB
 }
 
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+const appColumns = "id, name, client_secret_hash, disabled, created_at, secret_rotated_at"
+
 func NewPostgresRepository(dataSourceName string) (*PostgresRepository, error) {
 	db, err := sql.Open("pgx", dataSourceName)
 	if err != nil {
@@ -31,7 +38,7 @@ func NewPostgresRepository(dataSourceName string) (*PostgresRepository, error) {
 func (s *PostgresRepository) App(ctx context.Context, id int64) (domain.App, error) {
 	const op = "repositories.app.postgres.App"
 
-	stmt, err := s.db.Prepare("SELECT id, name, secret FROM apps WHERE id = $1")
+	stmt, err := s.db.Prepare("SELECT " + appColumns + " FROM apps WHERE id = $1")
 	if err != nil {
 
 		return domain.App{}, fmt.Errorf("%s: %w", op, err)
@@ -39,8 +46,7 @@ func (s *PostgresRepository) App(ctx context.Context, id int64) (domain.App, err
 
 	row := stmt.QueryRowContext(ctx, id)
 
-	var app domain.App
-	err = row.Scan(&app.ID, &app.Name, &app.Secret)
+	app, err := scanApp(row)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 
@@ -52,3 +58,151 @@ func (s *PostgresRepository) App(ctx context.Context, id int64) (domain.App, err
 
 	return app, nil
 }
+
+func (s *PostgresRepository) Apps(ctx context.Context) ([]domain.App, error) {
+	const op = "repositories.app.postgres.Apps"
+
+	rows, err := s.db.QueryContext(ctx, "SELECT "+appColumns+" FROM apps ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var apps []domain.App
+	for rows.Next() {
+		app, err := scanApp(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		apps = append(apps, app)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return apps, nil
+}
+
+// Create registers the app with the hash of its client secret.
+// It returns repositories.ErrAppExists if the name is taken.
+func (s *PostgresRepository) Create(ctx context.Context, name string, secretHash []byte) (domain.App, error) {
+	const op = "repositories.app.postgres.Create"
+
+	app := domain.App{Name: name, SecretHash: secretHash}
+	err := s.db.QueryRowContext(ctx,
+		"INSERT INTO apps (name, client_secret_hash) VALUES ($1, $2) RETURNING id, created_at, secret_rotated_at",
+		name, secretHash,
+	).Scan(&app.ID, &app.CreatedAt, &app.SecretRotatedAt)
+	if err != nil {
+		var pqErr *pgconn.PgError
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return domain.App{}, fmt.Errorf("%s: %w", op, repositories.ErrAppExists)
+		}
+		return domain.App{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return app, nil
+}
+
+// UpdateSecret replaces the hash of the client secret, the previous secret stops authenticating the app.
+func (s *PostgresRepository) UpdateSecret(ctx context.Context, id int64, secretHash []byte) error {
+	const op = "repositories.app.postgres.UpdateSecret"
+
+	res, err := s.db.ExecContext(ctx,
+		"UPDATE apps SET client_secret_hash = $2, secret_rotated_at = now() WHERE id = $1", id, secretHash)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return expectApp(op, res)
+}
+
+func (s *PostgresRepository) SetDisabled(ctx context.Context, id int64, disabled bool) error {
+	const op = "repositories.app.postgres.SetDisabled"
+
+	res, err := s.db.ExecContext(ctx, "UPDATE apps SET disabled = $2 WHERE id = $1", id, disabled)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return expectApp(op, res)
+}
+
+func (s *PostgresRepository) Delete(ctx context.Context, id int64) error {
+	const op = "repositories.app.postgres.Delete"
+
+	res, err := s.db.ExecContext(ctx, "DELETE FROM apps WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return expectApp(op, res)
+}
+
+func (s *PostgresRepository) RedirectURIs(ctx context.Context, id int64) ([]string, error) {
+	const op = "repositories.app.postgres.RedirectURIs"
+
+	rows, err := s.db.QueryContext(ctx, "SELECT uri FROM app_redirect_uris WHERE app_id = $1 ORDER BY uri", id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var uris []string
+	for rows.Next() {
+		var uri string
+		if err := rows.Scan(&uri); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		uris = append(uris, uri)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return uris, nil
+}
+
+// SetRedirectURIs replaces redirect URIs of the app.
+func (s *PostgresRepository) SetRedirectURIs(ctx context.Context, id int64, uris []string) error {
+	const op = "repositories.app.postgres.SetRedirectURIs"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err := tx.QueryRowContext(ctx, "SELECT id FROM apps WHERE id = $1 FOR UPDATE", id).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, repositories.ErrAppNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM app_redirect_uris WHERE app_id = $1", id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	for _, uri := range uris {
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO app_redirect_uris (app_id, uri) VALUES ($1, $2) ON CONFLICT DO NOTHING", id, uri)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func scanApp(row scanner) (domain.App, error) {
+	var app domain.App
+	var name sql.NullString
+	err := row.Scan(&app.ID, &name, &app.SecretHash, &app.Disabled, &app.CreatedAt, &app.SecretRotatedAt)
+	app.Name = name.String
+	return app, err
+}
+
+func expectApp(op string, res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrAppNotFound)
+	}
+	return nil
+}
@@ -8,5 +8,10 @@ import (
 
 type Repository interface {
 	Create(ctx context.Context, folder domain.Folder) (uuid.UUID, error)
-	Get(ctx context.Context, id uuid.UUID) (*domain.Folder, error)
+	Get(ctx context.Context, id, userId uuid.UUID) (*domain.Folder, error)
+	GetByPath(ctx context.Context, userId uuid.UUID, names []string) (*domain.Folder, error)
+	List(ctx context.Context, userId uuid.UUID) ([]*domain.Folder, error)
+	Rename(ctx context.Context, id, userId uuid.UUID, name string) error
+	Move(ctx context.Context, id, userId, parentId uuid.UUID) error
+	Delete(ctx context.Context, id, userId uuid.UUID, mode domain.FolderDeleteMode) error
 }
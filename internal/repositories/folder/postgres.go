@@ -9,8 +9,16 @@ import (
 	"github.com/jackc/pgconn"
 	"github.com/s0vunia/password-manager/internal/domain"
 	"github.com/s0vunia/password-manager/internal/repositories"
+	"strings"
 )
 
+// SubtreeCTE selects ids of the folder $1 of user $2 and all its descendants into subtree.
+const SubtreeCTE = `WITH RECURSIVE subtree AS (
+		SELECT id FROM folders WHERE id = $1 AND user_id = $2
+		UNION ALL
+		SELECT folders.id FROM folders JOIN subtree ON folders.parent_id = subtree.id
+	)`
+
 type PostgresRepository struct {
 	db *sql.DB
 }
@@ -30,37 +38,41 @@ func NewPostgresRepository(dataSourceName string) (*PostgresRepository, error) {
 	return &PostgresRepository{db}, nil
 }
 
+// Create inserts folder into its parent, or into the root if folder has no parent.
 func (p *PostgresRepository) Create(ctx context.Context, folder domain.Folder) (uuid.UUID, error) {
 	const op = "repositories.folder.postgres.Create"
+
+	if folder.ParentId != uuid.Nil {
+		if _, err := p.Get(ctx, folder.ParentId, folder.UserId); err != nil {
+			return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	var lastInsertId uuid.UUID
-	stmt, err := p.db.Prepare("INSERT INTO folders(user_id, name) VALUES ($1, $2) RETURNING id")
+	stmt, err := p.db.Prepare("INSERT INTO folders(user_id, name, parent_id) VALUES ($1, $2, $3) RETURNING id")
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
 	}
-	row := stmt.QueryRowContext(ctx, folder.UserId, folder.Name)
+	row := stmt.QueryRowContext(ctx, folder.UserId, folder.Name, nullable(folder.ParentId))
 	err = row.Scan(&lastInsertId)
 	if err != nil {
-		var pqErr *pgconn.PgError
-		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
-			return uuid.UUID{}, fmt.Errorf("%s: %w", op, repositories.ErrFolderExists)
-		}
-		return uuid.UUID{}, fmt.Errorf("create user failure %e", err)
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, constraintError(err))
 	}
 	return lastInsertId, nil
 }
 
-func (p *PostgresRepository) Get(ctx context.Context, id uuid.UUID) (*domain.Folder, error) {
+func (p *PostgresRepository) Get(ctx context.Context, id, userId uuid.UUID) (*domain.Folder, error) {
 	const op = "repositories.folder.postgres.Get"
 
-	stmt, err := p.db.Prepare("SELECT id, user_id, name FROM folders WHERE id = $1")
+	stmt, err := p.db.Prepare("SELECT id, user_id, name, parent_id FROM folders WHERE id = $1 AND user_id = $2")
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	row := stmt.QueryRowContext(ctx, id)
+	row := stmt.QueryRowContext(ctx, id, userId)
 
 	var folder domain.Folder
-	err = row.Scan(&folder.ID, &folder.UserId, &folder.Name)
+	err = row.Scan(&folder.ID, &folder.UserId, &folder.Name, &folder.ParentId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("%s: %w", op, repositories.ErrFolderNotFound)
@@ -69,3 +81,211 @@ func (p *PostgresRepository) Get(ctx context.Context, id uuid.UUID) (*domain.Fol
 	}
 	return &folder, nil
 }
+
+// GetByPath walks folder names from the root of the vault down to the last one.
+func (p *PostgresRepository) GetByPath(ctx context.Context, userId uuid.UUID, names []string) (*domain.Folder, error) {
+	const op = "repositories.folder.postgres.GetByPath"
+
+	stmt, err := p.db.Prepare("SELECT id, user_id, name, parent_id FROM folders WHERE user_id = $1 AND parent_id IS NOT DISTINCT FROM $2 AND name = $3")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var folder domain.Folder
+	for _, name := range names {
+		err = stmt.QueryRowContext(ctx, userId, nullable(folder.ID), name).
+			Scan(&folder.ID, &folder.UserId, &folder.Name, &folder.ParentId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, fmt.Errorf("%s: %w", op, repositories.ErrFolderNotFound)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	folder.Path = strings.Join(names, domain.FolderPathSeparator)
+	return &folder, nil
+}
+
+// List returns all folders of the user with their full paths, ordered by path.
+func (p *PostgresRepository) List(ctx context.Context, userId uuid.UUID) ([]*domain.Folder, error) {
+	const op = "repositories.folder.postgres.List"
+
+	stmt, err := p.db.Prepare(`WITH RECURSIVE tree AS (
+			SELECT id, user_id, name, parent_id, name::text AS path FROM folders WHERE user_id = $1 AND parent_id IS NULL
+			UNION ALL
+			SELECT folders.id, folders.user_id, folders.name, folders.parent_id, tree.path || '` + domain.FolderPathSeparator + `' || folders.name
+			FROM folders JOIN tree ON folders.parent_id = tree.id
+		)
+		SELECT id, user_id, name, parent_id, path FROM tree ORDER BY path`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := stmt.QueryContext(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var folders []*domain.Folder
+	for rows.Next() {
+		var folder domain.Folder
+		if err := rows.Scan(&folder.ID, &folder.UserId, &folder.Name, &folder.ParentId, &folder.Path); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		folders = append(folders, &folder)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return folders, nil
+}
+
+func (p *PostgresRepository) Rename(ctx context.Context, id, userId uuid.UUID, name string) error {
+	const op = "repositories.folder.postgres.Rename"
+
+	stmt, err := p.db.Prepare("UPDATE folders SET name = $3 WHERE id = $1 AND user_id = $2")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	res, err := stmt.ExecContext(ctx, id, userId, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, constraintError(err))
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrFolderNotFound)
+	}
+	return nil
+}
+
+// Move reparents the folder together with its subtree, parentId uuid.Nil moves it to the root.
+// A folder can't be moved into itself or any of its descendants.
+func (p *PostgresRepository) Move(ctx context.Context, id, userId, parentId uuid.UUID) error {
+	const op = "repositories.folder.postgres.Move"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err := lockTree(ctx, tx, userId); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if parentId != uuid.Nil {
+		var cycle bool
+		err = tx.QueryRowContext(ctx,
+			SubtreeCTE+" SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $3)", id, userId, parentId,
+		).Scan(&cycle)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if cycle {
+			return fmt.Errorf("%s: %w", op, repositories.ErrFolderCycle)
+		}
+
+		err = tx.QueryRowContext(ctx, "SELECT id FROM folders WHERE id = $1 AND user_id = $2", parentId, userId).Scan(&parentId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%s: %w", op, repositories.ErrFolderNotFound)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	res, err := tx.ExecContext(ctx, "UPDATE folders SET parent_id = $3 WHERE id = $1 AND user_id = $2", id, userId, nullable(parentId))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, constraintError(err))
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrFolderNotFound)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Delete removes the folder with its whole subtree and, depending on mode,
+// moves items stored in the subtree to the trash or to the root of the vault.
+func (p *PostgresRepository) Delete(ctx context.Context, id, userId uuid.UUID, mode domain.FolderDeleteMode) error {
+	const op = "repositories.folder.postgres.Delete"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err := lockTree(ctx, tx, userId); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = tx.QueryRowContext(ctx, "SELECT id FROM folders WHERE id = $1 AND user_id = $2", id, userId).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, repositories.ErrFolderNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Items are detached from the folders in both modes, trashed ones included,
+	// so restoring them later puts them to the root.
+	if mode == domain.FolderDeleteCascade {
+		_, err = tx.ExecContext(ctx,
+			SubtreeCTE+` UPDATE items SET folder_id = NULL, deleted_at = COALESCE(deleted_at, now()), revision = revision + 1
+			WHERE user_id = $2 AND folder_id IN (SELECT id FROM subtree)`,
+			id, userId)
+	} else {
+		_, err = tx.ExecContext(ctx,
+			SubtreeCTE+" UPDATE items SET folder_id = NULL, revision = revision + 1 WHERE user_id = $2 AND folder_id IN (SELECT id FROM subtree)",
+			id, userId)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx, SubtreeCTE+" DELETE FROM folders WHERE id IN (SELECT id FROM subtree)", id, userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// lockTree serializes structural changes of the user's folder tree till the end of tx,
+// otherwise two concurrent moves could build a cycle together.
+func lockTree(ctx context.Context, tx *sql.Tx, userId uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", userId.String())
+	return err
+}
+
+func nullable(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
+}
+
+// constraintError maps sibling name conflicts and vanished parents to repository errors.
+func constraintError(err error) error {
+	var pqErr *pgconn.PgError
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23505":
+			return repositories.ErrFolderExists
+		case "23503":
+			return repositories.ErrFolderNotFound
+		}
+	}
+	return err
+}
@@ -0,0 +1,15 @@
+package apiKeyItem
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+)
+
+type Repository interface {
+	CreateAPIKeyItem(ctx context.Context, item domain.APIKeyItem) (uuid.UUID, error)
+	GetAPIKeyItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.APIKeyItem, error)
+	GetAPIKeyItems(ctx context.Context, userId uuid.UUID) ([]*domain.APIKeyItem, error)
+	UpdateAPIKeyItem(ctx context.Context, item domain.APIKeyItem) error
+	DeleteAPIKeyItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
@@ -0,0 +1,181 @@
+package apiKeyItem
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+)
+
+type ItemSaver interface {
+	CreateItem(ctx context.Context, item domain.Item) (uuid.UUID, error)
+}
+
+type PostgresRepository struct {
+	db        *sql.DB
+	itemSaver ItemSaver
+}
+
+func NewPostgresRepository(dataSourceName string, itemSaver ItemSaver) (*PostgresRepository, error) {
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Check the connection
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresRepository{db, itemSaver}, nil
+}
+
+const selectAPIKeyItem = `SELECT api_key_items.id, key_id, secret, service, expires_at,
+	items.id, items.type, items.name, items.folder_id, items.user_id, items.is_favorite, COALESCE(items.summary, ''), items.revision
+	FROM api_key_items JOIN items ON items.id = api_key_items.item_id`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAPIKeyItem(row scanner) (*domain.APIKeyItem, error) {
+	var apiKeyItem domain.APIKeyItem
+	err := row.Scan(&apiKeyItem.ID, &apiKeyItem.KeyId, &apiKeyItem.Secret, &apiKeyItem.Service, &apiKeyItem.ExpiresAt,
+		&apiKeyItem.Item.ID, &apiKeyItem.Type, &apiKeyItem.Name, &apiKeyItem.FolderId,
+		&apiKeyItem.UserId, &apiKeyItem.IsFavorite, &apiKeyItem.Summary, &apiKeyItem.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return &apiKeyItem, nil
+}
+
+func (p *PostgresRepository) CreateAPIKeyItem(ctx context.Context, item domain.APIKeyItem) (uuid.UUID, error) {
+	const op = "repositories.item.apiKeyItem.postgres.CreateAPIKeyItem"
+
+	itemId, err := p.itemSaver.CreateItem(ctx, item.Item)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	stmt, err := p.db.Prepare(`INSERT INTO api_key_items (id, item_id, key_id, secret, service, expires_at)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5) RETURNING ID`)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	var id uuid.UUID
+	row := stmt.QueryRowContext(ctx, itemId, item.KeyId, item.Secret, item.Service, item.ExpiresAt)
+	err = row.Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (p *PostgresRepository) GetAPIKeyItem(ctx context.Context, apiKeyItemId, userId uuid.UUID) (*domain.APIKeyItem, error) {
+	const op = "repositories.item.apiKeyItem.postgres.GetAPIKeyItem"
+
+	stmt, err := p.db.Prepare(selectAPIKeyItem + " WHERE api_key_items.id = $1 AND user_id = $2 AND deleted_at IS NULL")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	apiKeyItem, err := scanAPIKeyItem(stmt.QueryRowContext(ctx, apiKeyItemId, userId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return apiKeyItem, nil
+}
+
+func (p *PostgresRepository) GetAPIKeyItems(ctx context.Context, userId uuid.UUID) ([]*domain.APIKeyItem, error) {
+	const op = "repositories.item.apiKeyItem.postgres.GetAPIKeyItems"
+
+	stmt, err := p.db.Prepare(selectAPIKeyItem + " WHERE user_id = $1 AND deleted_at IS NULL")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := stmt.QueryContext(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var items []*domain.APIKeyItem
+	for rows.Next() {
+		apiKeyItem, err := scanAPIKeyItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, apiKeyItem)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+// UpdateAPIKeyItem replaces item attributes and key details in one transaction.
+func (p *PostgresRepository) UpdateAPIKeyItem(ctx context.Context, item domain.APIKeyItem) error {
+	const op = "repositories.item.apiKeyItem.postgres.UpdateAPIKeyItem"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var itemId uuid.UUID
+	err = tx.QueryRowContext(ctx,
+		`UPDATE api_key_items SET key_id = $3, secret = $4, service = $5, expires_at = $6
+		FROM items WHERE items.id = api_key_items.item_id AND api_key_items.id = $1 AND items.user_id = $2 AND items.deleted_at IS NULL RETURNING items.id`,
+		item.ID, item.UserId, item.KeyId, item.Secret, item.Service, item.ExpiresAt,
+	).Scan(&itemId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE items SET name = $2, folder_id = $3, is_favorite = $4, summary = $5, revision = revision + 1 WHERE id = $1",
+		itemId, item.Name, item.FolderId, item.IsFavorite, item.Summary,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (p *PostgresRepository) DeleteAPIKeyItem(ctx context.Context, userId uuid.UUID, apiKeyItemId uuid.UUID) error {
+	const op = "repositories.item.apiKeyItem.postgres.DeleteAPIKeyItem"
+
+	stmt, err := p.db.Prepare("UPDATE items SET deleted_at = now(), revision = revision + 1 FROM api_key_items WHERE items.id = api_key_items.item_id AND api_key_items.id = $1 AND items.user_id = $2 AND items.deleted_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	res, err := stmt.ExecContext(ctx, apiKeyItemId, userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package cardItem
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+)
+
+type Repository interface {
+	CreateCardItem(ctx context.Context, item domain.CardItem) (uuid.UUID, error)
+	GetCardItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.CardItem, error)
+	GetCardItems(ctx context.Context, userId uuid.UUID) ([]*domain.CardItem, error)
+	UpdateCardItem(ctx context.Context, item domain.CardItem) (int64, error)
+	DeleteCardItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
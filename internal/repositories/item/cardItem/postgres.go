@@ -0,0 +1,187 @@
+package cardItem
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/repositories/item"
+)
+
+type ItemSaver interface {
+	CreateItem(ctx context.Context, item domain.Item) (uuid.UUID, error)
+}
+
+type PostgresRepository struct {
+	db        *sql.DB
+	itemSaver ItemSaver
+}
+
+func NewPostgresRepository(dataSourceName string, itemSaver ItemSaver) (*PostgresRepository, error) {
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Check the connection
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresRepository{db, itemSaver}, nil
+}
+
+const selectCardItem = `SELECT card_items.id, cardholder, number, expiry_month, expiry_year, cvv, brand,
+	items.id, items.type, items.name, items.folder_id, items.user_id, items.is_favorite, COALESCE(items.summary, ''), items.revision
+	FROM card_items JOIN items ON items.id = card_items.item_id`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCardItem(row scanner) (*domain.CardItem, error) {
+	var cardItem domain.CardItem
+	err := row.Scan(&cardItem.ID, &cardItem.Cardholder, &cardItem.Number, &cardItem.ExpiryMonth, &cardItem.ExpiryYear,
+		&cardItem.CVV, &cardItem.Brand, &cardItem.Item.ID, &cardItem.Type, &cardItem.Name, &cardItem.FolderId,
+		&cardItem.UserId, &cardItem.IsFavorite, &cardItem.Summary, &cardItem.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return &cardItem, nil
+}
+
+func (p *PostgresRepository) CreateCardItem(ctx context.Context, item domain.CardItem) (uuid.UUID, error) {
+	const op = "repositories.item.cardItem.postgres.CreateCardItem"
+
+	itemId, err := p.itemSaver.CreateItem(ctx, item.Item)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	stmt, err := p.db.Prepare(`INSERT INTO card_items (id, item_id, cardholder, number, expiry_month, expiry_year, cvv, brand)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7) RETURNING ID`)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	var id uuid.UUID
+	row := stmt.QueryRowContext(ctx, itemId, item.Cardholder, item.Number, item.ExpiryMonth, item.ExpiryYear, item.CVV, item.Brand)
+	err = row.Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (p *PostgresRepository) GetCardItem(ctx context.Context, cardItemId, userId uuid.UUID) (*domain.CardItem, error) {
+	const op = "repositories.item.cardItem.postgres.GetCardItem"
+
+	stmt, err := p.db.Prepare(selectCardItem + " WHERE card_items.id = $1 AND user_id = $2 AND deleted_at IS NULL")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cardItem, err := scanCardItem(stmt.QueryRowContext(ctx, cardItemId, userId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return cardItem, nil
+}
+
+func (p *PostgresRepository) GetCardItems(ctx context.Context, userId uuid.UUID) ([]*domain.CardItem, error) {
+	const op = "repositories.item.cardItem.postgres.GetCardItems"
+
+	stmt, err := p.db.Prepare(selectCardItem + " WHERE user_id = $1 AND deleted_at IS NULL")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := stmt.QueryContext(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var items []*domain.CardItem
+	for rows.Next() {
+		cardItem, err := scanCardItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, cardItem)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+// UpdateCardItem replaces item attributes and card details in one transaction
+// if the item revision is still item.Revision, and returns the new revision.
+// The base item is updated first, so its row lock serializes concurrent updates.
+func (p *PostgresRepository) UpdateCardItem(ctx context.Context, cardItem domain.CardItem) (int64, error) {
+	const op = "repositories.item.cardItem.postgres.UpdateCardItem"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var revision int64
+	err = tx.QueryRowContext(ctx,
+		`UPDATE items SET name = $4, folder_id = $5, is_favorite = $6, summary = $7, revision = revision + 1
+		FROM card_items WHERE items.id = card_items.item_id AND card_items.id = $1
+		AND items.user_id = $2 AND items.revision = $3 AND items.deleted_at IS NULL RETURNING items.revision`,
+		cardItem.ID, cardItem.UserId, cardItem.Revision, cardItem.Name, cardItem.FolderId, cardItem.IsFavorite, cardItem.Summary,
+	).Scan(&revision)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = item.RevisionError(ctx, tx,
+				"SELECT items.revision FROM card_items JOIN items ON items.id = card_items.item_id WHERE card_items.id = $1 AND user_id = $2 AND deleted_at IS NULL",
+				cardItem.ID, cardItem.UserId)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE card_items SET cardholder = $2, number = $3, expiry_month = $4, expiry_year = $5, cvv = $6, brand = $7 WHERE id = $1",
+		cardItem.ID, cardItem.Cardholder, cardItem.Number, cardItem.ExpiryMonth, cardItem.ExpiryYear, cardItem.CVV, cardItem.Brand,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return revision, nil
+}
+
+func (p *PostgresRepository) DeleteCardItem(ctx context.Context, userId uuid.UUID, cardItemId uuid.UUID) error {
+	const op = "repositories.item.cardItem.postgres.DeleteCardItem"
+
+	stmt, err := p.db.Prepare("UPDATE items SET deleted_at = now(), revision = revision + 1 FROM card_items WHERE items.id = card_items.item_id AND card_items.id = $1 AND items.user_id = $2 AND items.deleted_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	res, err := stmt.ExecContext(ctx, cardItemId, userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package identityItem
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+)
+
+type Repository interface {
+	CreateIdentityItem(ctx context.Context, item domain.IdentityItem) (uuid.UUID, error)
+	GetIdentityItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.IdentityItem, error)
+	GetIdentityItems(ctx context.Context, userId uuid.UUID) ([]*domain.IdentityItem, error)
+	UpdateIdentityItem(ctx context.Context, item domain.IdentityItem) error
+	DeleteIdentityItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
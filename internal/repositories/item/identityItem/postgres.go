@@ -0,0 +1,189 @@
+package identityItem
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+)
+
+type ItemSaver interface {
+	CreateItem(ctx context.Context, item domain.Item) (uuid.UUID, error)
+}
+
+type PostgresRepository struct {
+	db        *sql.DB
+	itemSaver ItemSaver
+}
+
+func NewPostgresRepository(dataSourceName string, itemSaver ItemSaver) (*PostgresRepository, error) {
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Check the connection
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresRepository{db, itemSaver}, nil
+}
+
+const identityColumns = `title, first_name, middle_name, last_name, address1, address2, city, state,
+	postal_code, country, phone, email, passport_number, national_id, license_number`
+
+const selectIdentityItem = `SELECT identity_items.id, ` + identityColumns + `,
+	items.id, items.type, items.name, items.folder_id, items.user_id, items.is_favorite, COALESCE(items.summary, ''), items.revision
+	FROM identity_items JOIN items ON items.id = identity_items.item_id`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanIdentityItem(row scanner) (*domain.IdentityItem, error) {
+	var i domain.IdentityItem
+	err := row.Scan(&i.ID, &i.Title, &i.FirstName, &i.MiddleName, &i.LastName, &i.Address1, &i.Address2,
+		&i.City, &i.State, &i.PostalCode, &i.Country, &i.Phone, &i.Email, &i.PassportNumber, &i.NationalId,
+		&i.LicenseNumber, &i.Item.ID, &i.Type, &i.Name, &i.FolderId, &i.UserId, &i.IsFavorite, &i.Summary, &i.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+func identityValues(i domain.IdentityItem) []any {
+	return []any{i.Title, i.FirstName, i.MiddleName, i.LastName, i.Address1, i.Address2, i.City, i.State,
+		i.PostalCode, i.Country, i.Phone, i.Email, i.PassportNumber, i.NationalId, i.LicenseNumber}
+}
+
+func (p *PostgresRepository) CreateIdentityItem(ctx context.Context, item domain.IdentityItem) (uuid.UUID, error) {
+	const op = "repositories.item.identityItem.postgres.CreateIdentityItem"
+
+	itemId, err := p.itemSaver.CreateItem(ctx, item.Item)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	stmt, err := p.db.Prepare(`INSERT INTO identity_items (id, item_id, ` + identityColumns + `)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) RETURNING ID`)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	var id uuid.UUID
+	row := stmt.QueryRowContext(ctx, append([]any{itemId}, identityValues(item)...)...)
+	err = row.Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (p *PostgresRepository) GetIdentityItem(ctx context.Context, identityItemId, userId uuid.UUID) (*domain.IdentityItem, error) {
+	const op = "repositories.item.identityItem.postgres.GetIdentityItem"
+
+	stmt, err := p.db.Prepare(selectIdentityItem + " WHERE identity_items.id = $1 AND user_id = $2 AND deleted_at IS NULL")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	identityItem, err := scanIdentityItem(stmt.QueryRowContext(ctx, identityItemId, userId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return identityItem, nil
+}
+
+func (p *PostgresRepository) GetIdentityItems(ctx context.Context, userId uuid.UUID) ([]*domain.IdentityItem, error) {
+	const op = "repositories.item.identityItem.postgres.GetIdentityItems"
+
+	stmt, err := p.db.Prepare(selectIdentityItem + " WHERE user_id = $1 AND deleted_at IS NULL")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := stmt.QueryContext(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var items []*domain.IdentityItem
+	for rows.Next() {
+		identityItem, err := scanIdentityItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, identityItem)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+// UpdateIdentityItem replaces item attributes and identity details in one transaction.
+func (p *PostgresRepository) UpdateIdentityItem(ctx context.Context, item domain.IdentityItem) error {
+	const op = "repositories.item.identityItem.postgres.UpdateIdentityItem"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var itemId uuid.UUID
+	err = tx.QueryRowContext(ctx,
+		`UPDATE identity_items SET (`+identityColumns+`) = ($3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		FROM items WHERE items.id = identity_items.item_id AND identity_items.id = $1 AND items.user_id = $2 AND items.deleted_at IS NULL RETURNING items.id`,
+		append([]any{item.ID, item.UserId}, identityValues(item)...)...,
+	).Scan(&itemId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE items SET name = $2, folder_id = $3, is_favorite = $4, summary = $5, revision = revision + 1 WHERE id = $1",
+		itemId, item.Name, item.FolderId, item.IsFavorite, item.Summary,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (p *PostgresRepository) DeleteIdentityItem(ctx context.Context, userId uuid.UUID, identityItemId uuid.UUID) error {
+	const op = "repositories.item.identityItem.postgres.DeleteIdentityItem"
+
+	stmt, err := p.db.Prepare("UPDATE items SET deleted_at = now(), revision = revision + 1 FROM identity_items WHERE items.id = identity_items.item_id AND identity_items.id = $1 AND items.user_id = $2 AND items.deleted_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	res, err := stmt.ExecContext(ctx, identityItemId, userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+	}
+	return nil
+}
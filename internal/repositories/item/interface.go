@@ -4,10 +4,17 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"github.com/s0vunia/password-manager/internal/domain"
+	"time"
 )
 
 type Repository interface {
 	GetItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.Item, error)
 	GetItems(ctx context.Context, userId uuid.UUID) ([]*domain.Item, error)
+	GetItemsByFolder(ctx context.Context, folderId, userId uuid.UUID, recursive bool) ([]*domain.Item, error)
 	CreateItem(ctx context.Context, item domain.Item) (uuid.UUID, error)
+	UpdateItem(ctx context.Context, patch domain.ItemPatch) (int64, error)
+	ListTrash(ctx context.Context, userId uuid.UUID) ([]*domain.Item, error)
+	RestoreItem(ctx context.Context, itemId, userId uuid.UUID) (int64, error)
+	PurgeItem(ctx context.Context, itemId, userId uuid.UUID) error
+	PurgeExpired(ctx context.Context, retention time.Duration, limit int) (int64, error)
 }
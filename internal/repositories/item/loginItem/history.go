@@ -0,0 +1,94 @@
+package loginItem
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+)
+
+const selectLoginItemHistory = `SELECT login_item_history.id, login_item_id, login_item_history.login,
+	login_item_history.encrypt_password, created_at
+	FROM login_item_history
+	JOIN login_items ON login_items.id = login_item_history.login_item_id
+	JOIN items ON items.id = login_items.item_id`
+
+// GetLoginItemHistory returns history of the user's login item, newest entries first.
+func (p *PostgresRepository) GetLoginItemHistory(ctx context.Context, loginItemId, userId uuid.UUID) ([]*domain.LoginItemHistory, error) {
+	const op = "repositories.item.loginItem.postgres.GetLoginItemHistory"
+
+	var exists bool
+	err := p.db.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM login_items JOIN items ON items.id = login_items.item_id WHERE login_items.id = $1 AND user_id = $2)",
+		loginItemId, userId,
+	).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+	}
+
+	stmt, err := p.db.Prepare(selectLoginItemHistory + " WHERE login_item_id = $1 AND user_id = $2 ORDER BY created_at DESC")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := stmt.QueryContext(ctx, loginItemId, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var entries []*domain.LoginItemHistory
+	for rows.Next() {
+		var entry domain.LoginItemHistory
+		err = rows.Scan(&entry.ID, &entry.LoginItemId, &entry.Login, &entry.EncryptPassword, &entry.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		entries = append(entries, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return entries, nil
+}
+
+func (p *PostgresRepository) GetLoginItemHistoryEntry(ctx context.Context, historyId, loginItemId, userId uuid.UUID) (*domain.LoginItemHistory, error) {
+	const op = "repositories.item.loginItem.postgres.GetLoginItemHistoryEntry"
+
+	stmt, err := p.db.Prepare(selectLoginItemHistory + " WHERE login_item_history.id = $1 AND login_item_id = $2 AND user_id = $3")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var entry domain.LoginItemHistory
+	err = stmt.QueryRowContext(ctx, historyId, loginItemId, userId).
+		Scan(&entry.ID, &entry.LoginItemId, &entry.Login, &entry.EncryptPassword, &entry.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrHistoryNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &entry, nil
+}
+
+// PruneLoginItemHistory keeps only keep newest history entries of the login item.
+func (p *PostgresRepository) PruneLoginItemHistory(ctx context.Context, loginItemId uuid.UUID, keep int) error {
+	const op = "repositories.item.loginItem.postgres.PruneLoginItemHistory"
+
+	_, err := p.db.ExecContext(ctx,
+		`DELETE FROM login_item_history WHERE login_item_id = $1 AND id NOT IN (
+			SELECT id FROM login_item_history WHERE login_item_id = $1 ORDER BY created_at DESC LIMIT $2
+		)`,
+		loginItemId, keep,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
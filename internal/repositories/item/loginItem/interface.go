@@ -10,5 +10,10 @@ type Repository interface {
 	CreateLoginItem(ctx context.Context, item domain.LoginItem) (uuid.UUID, error)
 	GetLoginItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.LoginItem, error)
 	GetLoginItems(ctx context.Context, userId uuid.UUID) ([]*domain.LoginItem, error)
+	UpdateLoginItem(ctx context.Context, patch domain.LoginItemPatch) (int64, error)
+	GetLoginItemHistory(ctx context.Context, loginItemId, userId uuid.UUID) ([]*domain.LoginItemHistory, error)
+	GetLoginItemHistoryEntry(ctx context.Context, historyId, loginItemId, userId uuid.UUID) (*domain.LoginItemHistory, error)
+	PruneLoginItemHistory(ctx context.Context, loginItemId uuid.UUID, keep int) error
+	SealLegacyPassword(ctx context.Context, loginItemId uuid.UUID, legacy string, sealed string) error
 	DeleteLoginItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgconn"
 	"github.com/s0vunia/password-manager/internal/domain"
 	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/repositories/item"
 )
 
 type ItemSaver interface {
@@ -47,7 +48,7 @@ func (p *PostgresRepository) CreateLoginItem(ctx context.Context, item domain.Lo
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
 	}
-	stmt, err := p.db.Prepare("INSERT INTO login_items (id, item_id, login, encrypt_password) VALUES (gen_random_uuid(), $1, $2, $3) RETURNING ID")
+	stmt, err := p.db.Prepare("INSERT INTO login_items (id, item_id, login, encrypt_password, encrypt_totp) VALUES (gen_random_uuid(), $1, $2, $3, NULLIF($4, '')) RETURNING ID")
 	if err != nil {
 		var pqErr *pgconn.PgError
 		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
@@ -56,7 +57,7 @@ func (p *PostgresRepository) CreateLoginItem(ctx context.Context, item domain.Lo
 		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
 	}
 	var id uuid.UUID
-	row := stmt.QueryRowContext(ctx, itemId, item.Login, item.EncryptPassword)
+	row := stmt.QueryRowContext(ctx, itemId, item.Login, item.EncryptPassword, item.EncryptTOTP)
 	err = row.Scan(&id)
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
@@ -68,7 +69,7 @@ func (p *PostgresRepository) CreateLoginItem(ctx context.Context, item domain.Lo
 func (p *PostgresRepository) GetLoginItem(ctx context.Context, loginItemId, userId uuid.UUID) (*domain.LoginItem, error) {
 	const op = "repositories.loginItem.loginItem.postgres.GetLoginItem"
 
-	stmt, err := p.db.Prepare("SELECT login_items.id, login, encrypt_password, items.id FROM login_items JOIN items on items.id = login_items.item_id WHERE login_items.id = $1 AND user_id=$2")
+	stmt, err := p.db.Prepare("SELECT login_items.id, login, encrypt_password, COALESCE(encrypt_totp, ''), password_changed_at, items.id FROM login_items JOIN items on items.id = login_items.item_id WHERE login_items.id = $1 AND user_id=$2 AND deleted_at IS NULL")
 	if err != nil {
 		return &domain.LoginItem{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -77,7 +78,7 @@ func (p *PostgresRepository) GetLoginItem(ctx context.Context, loginItemId, user
 
 	var loginItem domain.LoginItem
 	var itemId uuid.UUID
-	err = row.Scan(&loginItem.ID, &loginItem.Login, &loginItem.EncryptPassword, &itemId)
+	err = row.Scan(&loginItem.ID, &loginItem.Login, &loginItem.EncryptPassword, &loginItem.EncryptTOTP, &loginItem.PasswordChangedAt, &itemId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
@@ -97,7 +98,7 @@ func (p *PostgresRepository) GetLoginItem(ctx context.Context, loginItemId, user
 func (p *PostgresRepository) GetLoginItems(ctx context.Context, userId uuid.UUID) ([]*domain.LoginItem, error) {
 	const op = "repositories.item.loginItem.postgres.GetLoginItems"
 
-	stmt, err := p.db.Prepare("SELECT login_items.id, login, encrypt_password, items.id FROM login_items JOIN items on items.id = login_items.item_id WHERE user_id=$1")
+	stmt, err := p.db.Prepare("SELECT login_items.id, login, encrypt_password, COALESCE(encrypt_totp, ''), password_changed_at, items.id FROM login_items JOIN items on items.id = login_items.item_id WHERE user_id=$1 AND deleted_at IS NULL")
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -111,7 +112,7 @@ func (p *PostgresRepository) GetLoginItems(ctx context.Context, userId uuid.UUID
 	for rows.Next() {
 		var loginItem domain.LoginItem
 		var itemId uuid.UUID
-		err = rows.Scan(&loginItem.ID, &loginItem.Login, &loginItem.EncryptPassword, &itemId)
+		err = rows.Scan(&loginItem.ID, &loginItem.Login, &loginItem.EncryptPassword, &loginItem.EncryptTOTP, &loginItem.PasswordChangedAt, &itemId)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
@@ -128,13 +129,103 @@ func (p *PostgresRepository) GetLoginItems(ctx context.Context, userId uuid.UUID
 	}
 	return items, nil
 }
+
+// UpdateLoginItem applies patch to the login item and its base item in one transaction
+// if the item revision is still patch.Revision, and returns the new revision.
+// Changing the password snapshots the previous credentials to login_item_history.
+// The base item is updated first, so its row lock serializes concurrent updates.
+func (p *PostgresRepository) UpdateLoginItem(ctx context.Context, patch domain.LoginItemPatch) (int64, error) {
+	const op = "repositories.item.loginItem.postgres.UpdateLoginItem"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if patch.FolderId != nil {
+		if err := item.CheckFolder(ctx, tx, *patch.FolderId, patch.UserId); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	var itemId uuid.UUID
+	var revision int64
+	err = tx.QueryRowContext(ctx,
+		`UPDATE items SET name = COALESCE($4, name), folder_id = COALESCE($5, folder_id),
+		is_favorite = COALESCE($6, is_favorite), revision = revision + 1
+		FROM login_items WHERE items.id = login_items.item_id AND login_items.id = $1
+		AND items.user_id = $2 AND items.revision = $3 AND items.deleted_at IS NULL RETURNING items.id, items.revision`,
+		patch.ID, patch.UserId, patch.Revision, patch.Name, patch.FolderId, patch.IsFavorite,
+	).Scan(&itemId, &revision)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = item.RevisionError(ctx, tx,
+				"SELECT items.revision FROM login_items JOIN items ON items.id = login_items.item_id WHERE login_items.id = $1 AND user_id = $2 AND deleted_at IS NULL",
+				patch.ID, patch.UserId)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if patch.EncryptPassword != nil {
+		// Keep previous credentials, so a failed password rotation can be rolled back.
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO login_item_history (login_item_id, login, encrypt_password) SELECT id, login, encrypt_password FROM login_items WHERE id = $1",
+			patch.ID,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE login_items SET login = COALESCE($2, login), encrypt_password = COALESCE($3, encrypt_password),
+		password_changed_at = CASE WHEN $3::text IS NULL THEN password_changed_at ELSE now() END,
+		encrypt_totp = CASE WHEN $4::text IS NULL THEN encrypt_totp ELSE NULLIF($4, '') END WHERE id = $1`,
+		patch.ID, patch.Login, patch.EncryptPassword, patch.EncryptTOTP,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return revision, nil
+}
+
+// SealLegacyPassword replaces the password stored before envelope encryption with the sealed one,
+// unless it was changed meanwhile.
+func (p *PostgresRepository) SealLegacyPassword(ctx context.Context, loginItemId uuid.UUID, legacy string, sealed string) error {
+	const op = "repositories.item.loginItem.postgres.SealLegacyPassword"
+
+	_, err := p.db.ExecContext(ctx,
+		"UPDATE login_items SET encrypt_password = $3 WHERE id = $1 AND encrypt_password = $2",
+		loginItemId, legacy, sealed)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// DeleteLoginItem moves the item to the trash, it's purged after the trash retention period.
 func (p *PostgresRepository) DeleteLoginItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error {
 	const op = "repositories.item.loginItem.postgres.DeleteLoginItem"
 
-	stmt, err := p.db.Prepare("DELETE FROM items WHERE id=$1 AND user_id=$2")
+	stmt, err := p.db.Prepare("UPDATE items SET deleted_at = now(), revision = revision + 1 WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	res, err := stmt.ExecContext(ctx, itemId, userId)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
-	stmt.QueryRowContext(ctx, itemId, userId)
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+	}
 	return nil
 }
@@ -0,0 +1,15 @@
+package noteItem
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+)
+
+type Repository interface {
+	CreateNoteItem(ctx context.Context, item domain.NoteItem) (uuid.UUID, error)
+	GetNoteItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.NoteItem, error)
+	GetNoteItems(ctx context.Context, userId uuid.UUID) ([]*domain.NoteItem, error)
+	UpdateNoteItem(ctx context.Context, item domain.NoteItem) error
+	DeleteNoteItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
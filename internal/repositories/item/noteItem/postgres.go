@@ -0,0 +1,169 @@
+package noteItem
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+)
+
+type ItemSaver interface {
+	CreateItem(ctx context.Context, item domain.Item) (uuid.UUID, error)
+}
+
+type PostgresRepository struct {
+	db        *sql.DB
+	itemSaver ItemSaver
+}
+
+func NewPostgresRepository(dataSourceName string, itemSaver ItemSaver) (*PostgresRepository, error) {
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Check the connection
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresRepository{db, itemSaver}, nil
+}
+
+const selectNoteItem = `SELECT note_items.id, note, items.id, items.type, items.name, items.folder_id, items.user_id, items.is_favorite, items.revision
+	FROM note_items JOIN items ON items.id = note_items.item_id`
+
+func (p *PostgresRepository) CreateNoteItem(ctx context.Context, item domain.NoteItem) (uuid.UUID, error) {
+	const op = "repositories.item.noteItem.postgres.CreateNoteItem"
+
+	itemId, err := p.itemSaver.CreateItem(ctx, item.Item)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	stmt, err := p.db.Prepare("INSERT INTO note_items (id, item_id, note) VALUES (gen_random_uuid(), $1, $2) RETURNING ID")
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	var id uuid.UUID
+	row := stmt.QueryRowContext(ctx, itemId, item.Note)
+	err = row.Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (p *PostgresRepository) GetNoteItem(ctx context.Context, noteItemId, userId uuid.UUID) (*domain.NoteItem, error) {
+	const op = "repositories.item.noteItem.postgres.GetNoteItem"
+
+	stmt, err := p.db.Prepare(selectNoteItem + " WHERE note_items.id = $1 AND user_id = $2 AND deleted_at IS NULL")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	row := stmt.QueryRowContext(ctx, noteItemId, userId)
+
+	var noteItem domain.NoteItem
+	err = row.Scan(&noteItem.ID, &noteItem.Note, &noteItem.Item.ID, &noteItem.Type, &noteItem.Name,
+		&noteItem.FolderId, &noteItem.UserId, &noteItem.IsFavorite, &noteItem.Revision)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &noteItem, nil
+}
+
+func (p *PostgresRepository) GetNoteItems(ctx context.Context, userId uuid.UUID) ([]*domain.NoteItem, error) {
+	const op = "repositories.item.noteItem.postgres.GetNoteItems"
+
+	stmt, err := p.db.Prepare(selectNoteItem + " WHERE user_id = $1 AND deleted_at IS NULL")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := stmt.QueryContext(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var items []*domain.NoteItem
+	for rows.Next() {
+		var noteItem domain.NoteItem
+		err = rows.Scan(&noteItem.ID, &noteItem.Note, &noteItem.Item.ID, &noteItem.Type, &noteItem.Name,
+			&noteItem.FolderId, &noteItem.UserId, &noteItem.IsFavorite, &noteItem.Revision)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, &noteItem)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+// UpdateNoteItem replaces item attributes and the note in one transaction.
+func (p *PostgresRepository) UpdateNoteItem(ctx context.Context, item domain.NoteItem) error {
+	const op = "repositories.item.noteItem.postgres.UpdateNoteItem"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var itemId uuid.UUID
+	err = tx.QueryRowContext(ctx,
+		"UPDATE note_items SET note = $3 FROM items WHERE items.id = note_items.item_id AND note_items.id = $1 AND items.user_id = $2 AND items.deleted_at IS NULL RETURNING items.id",
+		item.ID, item.UserId, item.Note,
+	).Scan(&itemId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE items SET name = $2, folder_id = $3, is_favorite = $4, revision = revision + 1 WHERE id = $1",
+		itemId, item.Name, item.FolderId, item.IsFavorite,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (p *PostgresRepository) DeleteNoteItem(ctx context.Context, userId uuid.UUID, noteItemId uuid.UUID) error {
+	const op = "repositories.item.noteItem.postgres.DeleteNoteItem"
+
+	stmt, err := p.db.Prepare("UPDATE items SET deleted_at = now(), revision = revision + 1 FROM note_items WHERE items.id = note_items.item_id AND note_items.id = $1 AND items.user_id = $2 AND items.deleted_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	res, err := stmt.ExecContext(ctx, noteItemId, userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+	}
+	return nil
+}
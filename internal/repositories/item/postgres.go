@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/s0vunia/password-manager/internal/domain"
 	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/repositories/folder"
+	"time"
 )
 
 type PostgresRepository struct {
@@ -32,7 +34,7 @@ func NewPostgresRepository(dataSourceName string) (*PostgresRepository, error) {
 func (p *PostgresRepository) GetItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.Item, error) {
 	const op = "repositories.item.postgres.GetItem"
 
-	stmt, err := p.db.Prepare("SELECT id, type, name, folder_id, user_id, is_favorite FROM items WHERE id = $1 AND user_id=$2")
+	stmt, err := p.db.Prepare("SELECT id, type, name, folder_id, user_id, is_favorite, COALESCE(summary, ''), revision FROM items WHERE id = $1 AND user_id=$2 AND deleted_at IS NULL")
 	if err != nil {
 		return &domain.Item{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -40,7 +42,7 @@ func (p *PostgresRepository) GetItem(ctx context.Context, itemId, userId uuid.UU
 	row := stmt.QueryRowContext(ctx, itemId.String(), userId.String())
 
 	var item domain.Item
-	err = row.Scan(&item.ID, &item.Type, &item.Name, &item.FolderId, &item.UserId, &item.IsFavorite)
+	err = row.Scan(&item.ID, &item.Type, &item.Name, &item.FolderId, &item.UserId, &item.IsFavorite, &item.Summary, &item.Revision)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return &item, fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
@@ -55,7 +57,7 @@ func (p *PostgresRepository) GetItem(ctx context.Context, itemId, userId uuid.UU
 func (p *PostgresRepository) GetItems(ctx context.Context, userId uuid.UUID) ([]*domain.Item, error) {
 	const op = "repositories.item.postgres.GetItems"
 
-	stmt, err := p.db.Prepare("SELECT id, type, name, folder_id, user_id, is_favorite FROM items WHERE user_id=$1")
+	stmt, err := p.db.Prepare("SELECT id, type, name, folder_id, user_id, is_favorite, COALESCE(summary, ''), revision FROM items WHERE user_id=$1 AND deleted_at IS NULL")
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -68,7 +70,7 @@ func (p *PostgresRepository) GetItems(ctx context.Context, userId uuid.UUID) ([]
 	var items []*domain.Item
 	for rows.Next() {
 		var item domain.Item
-		err = rows.Scan(&item.ID, &item.Type, &item.Name, &item.FolderId, &item.UserId, &item.IsFavorite)
+		err = rows.Scan(&item.ID, &item.Type, &item.Name, &item.FolderId, &item.UserId, &item.IsFavorite, &item.Summary, &item.Revision)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
@@ -83,12 +85,14 @@ func (p *PostgresRepository) GetItems(ctx context.Context, userId uuid.UUID) ([]
 func (p *PostgresRepository) CreateItem(ctx context.Context, item domain.Item) (uuid.UUID, error) {
 	const op = "repositories.item.postgres.CreateItem"
 
-	stmt, err := p.db.Prepare("INSERT INTO items (id, type, name, folder_id, user_id, is_favorite) VALUES (gen_random_uuid(), $1, $2, $3, $4, $5) RETURNING ID")
+	stmt, err := p.db.Prepare("INSERT INTO items (id, type, name, folder_id, user_id, is_favorite, summary) VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6) RETURNING ID")
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
 	}
 	var id uuid.UUID
-	row := stmt.QueryRowContext(ctx, item.Type, item.Name, item.FolderId, item.UserId, item.IsFavorite)
+	// Items without folder are stored in the root of the vault.
+	folderId := uuid.NullUUID{UUID: item.FolderId, Valid: item.FolderId != uuid.Nil}
+	row := stmt.QueryRowContext(ctx, item.Type, item.Name, folderId, item.UserId, item.IsFavorite, item.Summary)
 	err = row.Scan(&id)
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
@@ -96,3 +100,169 @@ func (p *PostgresRepository) CreateItem(ctx context.Context, item domain.Item) (
 
 	return id, nil
 }
+
+// UpdateItem applies patch to the item if its revision is still patch.Revision
+// and returns the new revision.
+func (p *PostgresRepository) UpdateItem(ctx context.Context, patch domain.ItemPatch) (int64, error) {
+	const op = "repositories.item.postgres.UpdateItem"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if patch.FolderId != nil {
+		if err := CheckFolder(ctx, tx, *patch.FolderId, patch.UserId); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	var revision int64
+	err = tx.QueryRowContext(ctx,
+		`UPDATE items SET name = COALESCE($4, name), folder_id = COALESCE($5, folder_id),
+		is_favorite = COALESCE($6, is_favorite), revision = revision + 1
+		WHERE id = $1 AND user_id = $2 AND revision = $3 AND deleted_at IS NULL RETURNING revision`,
+		patch.ID, patch.UserId, patch.Revision, patch.Name, patch.FolderId, patch.IsFavorite,
+	).Scan(&revision)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = RevisionError(ctx, tx, "SELECT revision FROM items WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL", patch.ID, patch.UserId)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return revision, nil
+}
+
+// GetItemsByFolder returns user's items stored in the folder, or anywhere
+// in its subtree if recursive is set. The folder must belong to the user.
+func (p *PostgresRepository) GetItemsByFolder(ctx context.Context, folderId, userId uuid.UUID, recursive bool) ([]*domain.Item, error) {
+	const op = "repositories.item.postgres.GetItemsByFolder"
+
+	if err := CheckFolder(ctx, p.db, folderId, userId); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := "SELECT id, type, name, folder_id, user_id, is_favorite, COALESCE(summary, ''), revision FROM items WHERE folder_id = $1 AND user_id = $2 AND deleted_at IS NULL"
+	if recursive {
+		query = folder.SubtreeCTE + ` SELECT id, type, name, folder_id, user_id, is_favorite, COALESCE(summary, ''), revision
+			FROM items WHERE folder_id IN (SELECT id FROM subtree) AND user_id = $2 AND deleted_at IS NULL`
+	}
+	stmt, err := p.db.Prepare(query)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := stmt.QueryContext(ctx, folderId, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var items []*domain.Item
+	for rows.Next() {
+		var item domain.Item
+		err = rows.Scan(&item.ID, &item.Type, &item.Name, &item.FolderId, &item.UserId, &item.IsFavorite, &item.Summary, &item.Revision)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+// ListTrash returns user's deleted items, recently deleted first.
+func (p *PostgresRepository) ListTrash(ctx context.Context, userId uuid.UUID) ([]*domain.Item, error) {
+	const op = "repositories.item.postgres.ListTrash"
+
+	stmt, err := p.db.Prepare(`SELECT id, type, name, folder_id, user_id, is_favorite, COALESCE(summary, ''), revision, deleted_at
+		FROM items WHERE user_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := stmt.QueryContext(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var items []*domain.Item
+	for rows.Next() {
+		var item domain.Item
+		err = rows.Scan(&item.ID, &item.Type, &item.Name, &item.FolderId, &item.UserId, &item.IsFavorite, &item.Summary,
+			&item.Revision, &item.DeletedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+// RestoreItem moves the item out of the trash and returns its new revision.
+func (p *PostgresRepository) RestoreItem(ctx context.Context, itemId, userId uuid.UUID) (int64, error) {
+	const op = "repositories.item.postgres.RestoreItem"
+
+	var revision int64
+	err := p.db.QueryRowContext(ctx,
+		"UPDATE items SET deleted_at = NULL, revision = revision + 1 WHERE id = $1 AND user_id = $2 AND deleted_at IS NOT NULL RETURNING revision",
+		itemId, userId,
+	).Scan(&revision)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return revision, nil
+}
+
+// PurgeItem permanently deletes the item, only items in the trash can be purged.
+func (p *PostgresRepository) PurgeItem(ctx context.Context, itemId, userId uuid.UUID) error {
+	const op = "repositories.item.postgres.PurgeItem"
+
+	res, err := p.db.ExecContext(ctx, "DELETE FROM items WHERE id = $1 AND user_id = $2 AND deleted_at IS NOT NULL", itemId, userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+	}
+	return nil
+}
+
+// PurgeExpired permanently deletes up to limit items of all users which are in the trash
+// longer than retention and returns the number of deleted items.
+// The deadline is computed by the database, the same clock that set deleted_at.
+func (p *PostgresRepository) PurgeExpired(ctx context.Context, retention time.Duration, limit int) (int64, error) {
+	const op = "repositories.item.postgres.PurgeExpired"
+
+	res, err := p.db.ExecContext(ctx,
+		`DELETE FROM items WHERE id IN (
+			SELECT id FROM items WHERE deleted_at < now() - make_interval(secs => $1)
+			ORDER BY deleted_at LIMIT $2 FOR UPDATE SKIP LOCKED
+		)`,
+		retention.Seconds(), limit,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return affected, nil
+}
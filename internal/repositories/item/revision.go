@@ -0,0 +1,45 @@
+package item
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/repositories"
+)
+
+// Querier is implemented by both *sql.DB and *sql.Tx.
+type Querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// RevisionError explains why a conditional update matched no rows: query must
+// select the item revision, so missing row means the item doesn't exist and
+// existing one means the client's revision is stale.
+func RevisionError(ctx context.Context, q Querier, query string, args ...any) error {
+	var revision int64
+	err := q.QueryRowContext(ctx, query, args...).Scan(&revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repositories.ErrItemNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return repositories.ErrRevisionMismatch
+}
+
+// CheckFolder makes sure the folder exists and belongs to the user,
+// so items can't be moved to other users' folders.
+func CheckFolder(ctx context.Context, q Querier, folderId, userId uuid.UUID) error {
+	var exists bool
+	err := q.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM folders WHERE id = $1 AND user_id = $2)", folderId, userId,
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return repositories.ErrFolderNotFound
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package sshKeyItem
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+)
+
+type Repository interface {
+	CreateSSHKeyItem(ctx context.Context, item domain.SSHKeyItem) (uuid.UUID, error)
+	GetSSHKeyItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.SSHKeyItem, error)
+	GetSSHKeyItems(ctx context.Context, userId uuid.UUID) ([]*domain.SSHKeyItem, error)
+	UpdateSSHKeyItem(ctx context.Context, item domain.SSHKeyItem) error
+	DeleteSSHKeyItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
@@ -0,0 +1,182 @@
+package sshKeyItem
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+)
+
+type ItemSaver interface {
+	CreateItem(ctx context.Context, item domain.Item) (uuid.UUID, error)
+}
+
+type PostgresRepository struct {
+	db        *sql.DB
+	itemSaver ItemSaver
+}
+
+func NewPostgresRepository(dataSourceName string, itemSaver ItemSaver) (*PostgresRepository, error) {
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Check the connection
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresRepository{db, itemSaver}, nil
+}
+
+const selectSSHKeyItem = `SELECT ssh_key_items.id, private_key, passphrase, public_key, key_type, fingerprint, comment,
+	items.id, items.type, items.name, items.folder_id, items.user_id, items.is_favorite, COALESCE(items.summary, ''), items.revision
+	FROM ssh_key_items JOIN items ON items.id = ssh_key_items.item_id`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSSHKeyItem(row scanner) (*domain.SSHKeyItem, error) {
+	var sshKeyItem domain.SSHKeyItem
+	err := row.Scan(&sshKeyItem.ID, &sshKeyItem.PrivateKey, &sshKeyItem.Passphrase, &sshKeyItem.PublicKey, &sshKeyItem.KeyType,
+		&sshKeyItem.Fingerprint, &sshKeyItem.Comment,
+		&sshKeyItem.Item.ID, &sshKeyItem.Type, &sshKeyItem.Name, &sshKeyItem.FolderId,
+		&sshKeyItem.UserId, &sshKeyItem.IsFavorite, &sshKeyItem.Summary, &sshKeyItem.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return &sshKeyItem, nil
+}
+
+func (p *PostgresRepository) CreateSSHKeyItem(ctx context.Context, item domain.SSHKeyItem) (uuid.UUID, error) {
+	const op = "repositories.item.sshKeyItem.postgres.CreateSSHKeyItem"
+
+	itemId, err := p.itemSaver.CreateItem(ctx, item.Item)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	stmt, err := p.db.Prepare(`INSERT INTO ssh_key_items (id, item_id, private_key, passphrase, public_key, key_type, fingerprint, comment)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7) RETURNING ID`)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	var id uuid.UUID
+	row := stmt.QueryRowContext(ctx, itemId, item.PrivateKey, item.Passphrase, item.PublicKey, item.KeyType, item.Fingerprint, item.Comment)
+	err = row.Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (p *PostgresRepository) GetSSHKeyItem(ctx context.Context, sshKeyItemId, userId uuid.UUID) (*domain.SSHKeyItem, error) {
+	const op = "repositories.item.sshKeyItem.postgres.GetSSHKeyItem"
+
+	stmt, err := p.db.Prepare(selectSSHKeyItem + " WHERE ssh_key_items.id = $1 AND user_id = $2 AND deleted_at IS NULL")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sshKeyItem, err := scanSSHKeyItem(stmt.QueryRowContext(ctx, sshKeyItemId, userId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sshKeyItem, nil
+}
+
+func (p *PostgresRepository) GetSSHKeyItems(ctx context.Context, userId uuid.UUID) ([]*domain.SSHKeyItem, error) {
+	const op = "repositories.item.sshKeyItem.postgres.GetSSHKeyItems"
+
+	stmt, err := p.db.Prepare(selectSSHKeyItem + " WHERE user_id = $1 AND deleted_at IS NULL")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := stmt.QueryContext(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var items []*domain.SSHKeyItem
+	for rows.Next() {
+		sshKeyItem, err := scanSSHKeyItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, sshKeyItem)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+// UpdateSSHKeyItem replaces item attributes and key pair in one transaction.
+func (p *PostgresRepository) UpdateSSHKeyItem(ctx context.Context, item domain.SSHKeyItem) error {
+	const op = "repositories.item.sshKeyItem.postgres.UpdateSSHKeyItem"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var itemId uuid.UUID
+	err = tx.QueryRowContext(ctx,
+		`UPDATE ssh_key_items SET private_key = $3, passphrase = $4, public_key = $5, key_type = $6, fingerprint = $7, comment = $8
+		FROM items WHERE items.id = ssh_key_items.item_id AND ssh_key_items.id = $1 AND items.user_id = $2 AND items.deleted_at IS NULL RETURNING items.id`,
+		item.ID, item.UserId, item.PrivateKey, item.Passphrase, item.PublicKey, item.KeyType, item.Fingerprint, item.Comment,
+	).Scan(&itemId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE items SET name = $2, folder_id = $3, is_favorite = $4, summary = $5, revision = revision + 1 WHERE id = $1",
+		itemId, item.Name, item.FolderId, item.IsFavorite, item.Summary,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (p *PostgresRepository) DeleteSSHKeyItem(ctx context.Context, userId uuid.UUID, sshKeyItemId uuid.UUID) error {
+	const op = "repositories.item.sshKeyItem.postgres.DeleteSSHKeyItem"
+
+	stmt, err := p.db.Prepare("UPDATE items SET deleted_at = now(), revision = revision + 1 FROM ssh_key_items WHERE items.id = ssh_key_items.item_id AND ssh_key_items.id = $1 AND items.user_id = $2 AND items.deleted_at IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	res, err := stmt.ExecContext(ctx, sshKeyItemId, userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrItemNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+package oauth
+
+import (
+	"context"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"time"
+)
+
+type Repository interface {
+	CreateCode(ctx context.Context, codeHash []byte, code domain.AuthorizationCode, ttl time.Duration) error
+	TakeCode(ctx context.Context, codeHash []byte) (*domain.AuthorizationCode, error)
+}
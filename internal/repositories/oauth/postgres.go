@@ -0,0 +1,70 @@
+package oauth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"time"
+)
+
+type PostgresRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRepository(dataSourceName string) (*PostgresRepository, error) {
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Check the connection
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresRepository{db}, nil
+}
+
+// CreateCode stores the authorization code valid for ttl and drops expired ones.
+func (s *PostgresRepository) CreateCode(ctx context.Context, codeHash []byte, code domain.AuthorizationCode, ttl time.Duration) error {
+	const op = "repositories.oauth.postgres.CreateCode"
+
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM oauth_codes WHERE expires_at < now()"); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO oauth_codes (code_hash, app_id, user_id, redirect_uri, scope, nonce, code_challenge, auth_time, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now() + make_interval(secs => $9))`,
+		codeHash, code.AppId, code.UserId, code.RedirectURI, code.Scope, code.Nonce, code.CodeChallenge,
+		code.AuthTime.Unix(), ttl.Seconds())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// TakeCode deletes the code and returns what it grants, so a code is redeemed once.
+func (s *PostgresRepository) TakeCode(ctx context.Context, codeHash []byte) (*domain.AuthorizationCode, error) {
+	const op = "repositories.oauth.postgres.TakeCode"
+
+	var code domain.AuthorizationCode
+	var authTime int64
+	err := s.db.QueryRowContext(ctx,
+		`DELETE FROM oauth_codes c USING users u
+		WHERE c.code_hash = $1 AND c.expires_at > now() AND u.id = c.user_id
+		RETURNING c.app_id, c.user_id, u.login, c.redirect_uri, c.scope, c.nonce, c.code_challenge, c.auth_time`,
+		codeHash,
+	).Scan(&code.AppId, &code.UserId, &code.Login, &code.RedirectURI, &code.Scope, &code.Nonce, &code.CodeChallenge, &authTime)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrAuthorizationCodeNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	code.AuthTime = time.Unix(authTime, 0)
+	return &code, nil
+}
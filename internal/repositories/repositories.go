@@ -3,11 +3,29 @@ package repositories
 import "errors"
 
 var (
-	ErrUserExists     = errors.New("user already exists")
-	ErrUserNotFound   = errors.New("user not found")
-	ErrAppNotFound    = errors.New("app not found")
-	ErrItemNotFound   = errors.New("item not found")
-	ErrFolderExists   = errors.New("folder not exists")
-	ErrFolderNotFound = errors.New("folder not exists")
-	ErrItemExists     = errors.New("item already exists")
+	ErrUserExists        = errors.New("user already exists")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrAppNotFound       = errors.New("app not found")
+	ErrAppExists         = errors.New("app already exists")
+	ErrItemNotFound      = errors.New("item not found")
+	ErrFolderExists      = errors.New("folder already exists")
+	ErrFolderNotFound    = errors.New("folder not exists")
+	ErrFolderCycle       = errors.New("folder can't be moved into its own subtree")
+	ErrItemExists        = errors.New("item already exists")
+	ErrUserKeyExists     = errors.New("user key already exists")
+	ErrRevisionMismatch  = errors.New("item was modified concurrently")
+	ErrHistoryNotFound   = errors.New("history entry not found")
+	ErrTOTPEnabled       = errors.New("two-factor authentication is already enabled")
+	ErrTOTPNotEnrolled   = errors.New("two-factor authentication is not enrolled")
+	ErrChallengeNotFound = errors.New("login challenge not found or expired")
+
+	ErrWebAuthnCredentialExists   = errors.New("webauthn credential already registered")
+	ErrWebAuthnCredentialNotFound = errors.New("webauthn credential not found")
+
+	ErrSessionNotFound    = errors.New("session not found or expired")
+	ErrRefreshTokenReused = errors.New("refresh token was already used")
+
+	ErrAuthorizationCodeNotFound = errors.New("authorization code not found or expired")
+
+	ErrAPITokenNotFound = errors.New("api token not found or expired")
 )
@@ -0,0 +1,14 @@
+package revocation
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Repository interface {
+	RevokeToken(ctx context.Context, id uuid.UUID, userId uuid.UUID, expiresAt time.Time) error
+	RevokeUserTokens(ctx context.Context, userId uuid.UUID, before time.Time) error
+	RevokedTokens(ctx context.Context, now time.Time) (map[uuid.UUID]time.Time, error)
+	UserRevocations(ctx context.Context, since time.Time) (map[uuid.UUID]time.Time, error)
+}
@@ -0,0 +1,109 @@
+package revocation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+type PostgresRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRepository(dataSourceName string) (*PostgresRepository, error) {
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Check the connection
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresRepository{db}, nil
+}
+
+// RevokeToken rejects tokens with the id or of the session with the id until expiresAt,
+// when they expire anyway. Entries which expired already are dropped.
+func (s *PostgresRepository) RevokeToken(ctx context.Context, id uuid.UUID, userId uuid.UUID, expiresAt time.Time) error {
+	const op = "repositories.revocation.postgres.RevokeToken"
+
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM revoked_tokens WHERE expires_at < $1", time.Now().UnixMilli()); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO revoked_tokens (id, user_id, expires_at) VALUES ($1, $2, $3)
+		ON CONFLICT (id) DO UPDATE SET expires_at = GREATEST(revoked_tokens.expires_at, EXCLUDED.expires_at)`,
+		id, userId, expiresAt.UnixMilli())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// RevokeUserTokens rejects all tokens of the user issued at or before the time.
+func (s *PostgresRepository) RevokeUserTokens(ctx context.Context, userId uuid.UUID, before time.Time) error {
+	const op = "repositories.revocation.postgres.RevokeUserTokens"
+
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO user_token_revocations (user_id, revoked_before) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET revoked_before = GREATEST(user_token_revocations.revoked_before, EXCLUDED.revoked_before)`,
+		userId, before.UnixMilli())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// RevokedTokens returns revoked ids which haven't expired at now with their expiry.
+func (s *PostgresRepository) RevokedTokens(ctx context.Context, now time.Time) (map[uuid.UUID]time.Time, error) {
+	const op = "repositories.revocation.postgres.RevokedTokens"
+
+	rows, err := s.db.QueryContext(ctx, "SELECT id, expires_at FROM revoked_tokens WHERE expires_at > $1", now.UnixMilli())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	tokens := make(map[uuid.UUID]time.Time)
+	for rows.Next() {
+		var id uuid.UUID
+		var expiresAt int64
+		if err := rows.Scan(&id, &expiresAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		tokens[id] = time.UnixMilli(expiresAt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return tokens, nil
+}
+
+// UserRevocations returns users whose tokens issued after since are revoked, with the revocation time.
+func (s *PostgresRepository) UserRevocations(ctx context.Context, since time.Time) (map[uuid.UUID]time.Time, error) {
+	const op = "repositories.revocation.postgres.UserRevocations"
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT user_id, revoked_before FROM user_token_revocations WHERE revoked_before > $1", since.UnixMilli())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	users := make(map[uuid.UUID]time.Time)
+	for rows.Next() {
+		var userId uuid.UUID
+		var before int64
+		if err := rows.Scan(&userId, &before); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		users[userId] = time.UnixMilli(before)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return users, nil
+}
@@ -0,0 +1,16 @@
+package session
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"time"
+)
+
+type Repository interface {
+	Create(ctx context.Context, session domain.Session, tokenHash []byte, ttl time.Duration) (uuid.UUID, error)
+	Rotate(ctx context.Context, tokenHash []byte, newTokenHash []byte, ip string, ttl time.Duration) (*domain.Session, error)
+	List(ctx context.Context, userId uuid.UUID) ([]domain.Session, error)
+	Revoke(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID) error
+	RevokeAll(ctx context.Context, userId uuid.UUID) (int, error)
+}
@@ -0,0 +1,193 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"time"
+)
+
+type PostgresRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRepository(dataSourceName string) (*PostgresRepository, error) {
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Check the connection
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresRepository{db}, nil
+}
+
+// Create opens a session valid for ttl with its first refresh token and drops expired sessions.
+func (s *PostgresRepository) Create(ctx context.Context, session domain.Session, tokenHash []byte, ttl time.Duration) (uuid.UUID, error) {
+	const op = "repositories.session.postgres.Create"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM sessions WHERE expires_at < now()"); err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	var id uuid.UUID
+	err = tx.QueryRowContext(ctx,
+		`INSERT INTO sessions (user_id, app_id, device_name, ip, expires_at)
+		VALUES ($1, $2, $3, $4, now() + make_interval(secs => $5)) RETURNING id`,
+		session.UserId, session.AppId, session.DeviceName, session.IP, ttl.Seconds(),
+	).Scan(&id)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO refresh_tokens (token_hash, session_id) VALUES ($1, $2)", tokenHash, id); err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// Rotate exchanges the refresh token for newTokenHash and extends the session by ttl.
+// A token which was already rotated revokes its session and returns repositories.ErrRefreshTokenReused,
+// as either the client or an attacker holds a stolen copy. The revoked session is returned
+// with the error, so access tokens issued for it can be revoked too.
+func (s *PostgresRepository) Rotate(ctx context.Context, tokenHash []byte, newTokenHash []byte, ip string, ttl time.Duration) (*domain.Session, error) {
+	const op = "repositories.session.postgres.Rotate"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var sessionId uuid.UUID
+	err = tx.QueryRowContext(ctx,
+		"UPDATE refresh_tokens SET used_at = now() WHERE token_hash = $1 AND used_at IS NULL RETURNING session_id", tokenHash,
+	).Scan(&sessionId)
+	if errors.Is(err, sql.ErrNoRows) {
+		reused := domain.Session{}
+		err = tx.QueryRowContext(ctx,
+			`SELECT sessions.id, sessions.user_id FROM refresh_tokens JOIN sessions ON sessions.id = refresh_tokens.session_id
+			WHERE refresh_tokens.token_hash = $1`, tokenHash,
+		).Scan(&reused.ID, &reused.UserId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrSessionNotFound)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE sessions SET revoked_at = now() WHERE id = $1 AND revoked_at IS NULL", reused.ID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		return &reused, fmt.Errorf("%s: %w", op, repositories.ErrRefreshTokenReused)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	session := domain.Session{ID: sessionId}
+	err = tx.QueryRowContext(ctx,
+		`UPDATE sessions SET last_used_at = now(), ip = $2, expires_at = now() + make_interval(secs => $3)
+		FROM users WHERE sessions.id = $1 AND users.id = sessions.user_id AND sessions.revoked_at IS NULL AND sessions.expires_at > now()
+		RETURNING sessions.user_id, users.login, sessions.app_id, sessions.device_name, sessions.ip,
+		sessions.created_at, sessions.last_used_at, sessions.expires_at`,
+		sessionId, ip, ttl.Seconds(),
+	).Scan(&session.UserId, &session.Login, &session.AppId, &session.DeviceName, &session.IP,
+		&session.CreatedAt, &session.LastUsedAt, &session.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrSessionNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO refresh_tokens (token_hash, session_id) VALUES ($1, $2)", newTokenHash, sessionId); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &session, nil
+}
+
+// List returns active sessions of the user, the most recently used first.
+func (s *PostgresRepository) List(ctx context.Context, userId uuid.UUID) ([]domain.Session, error) {
+	const op = "repositories.session.postgres.List"
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, user_id, app_id, device_name, ip, created_at, last_used_at, expires_at FROM sessions
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > now() ORDER BY last_used_at DESC`, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var sessions []domain.Session
+	for rows.Next() {
+		var session domain.Session
+		err := rows.Scan(&session.ID, &session.UserId, &session.AppId, &session.DeviceName, &session.IP,
+			&session.CreatedAt, &session.LastUsedAt, &session.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return sessions, nil
+}
+
+// Revoke ends the user's session, its refresh token can't be used anymore.
+func (s *PostgresRepository) Revoke(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID) error {
+	const op = "repositories.session.postgres.Revoke"
+
+	res, err := s.db.ExecContext(ctx,
+		"UPDATE sessions SET revoked_at = now() WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > now()",
+		sessionId, userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrSessionNotFound)
+	}
+	return nil
+}
+
+// RevokeAll ends every active session of the user and returns how many were ended.
+func (s *PostgresRepository) RevokeAll(ctx context.Context, userId uuid.UUID) (int, error) {
+	const op = "repositories.session.postgres.RevokeAll"
+
+	res, err := s.db.ExecContext(ctx,
+		"UPDATE sessions SET revoked_at = now() WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > now()",
+		userId)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return int(affected), nil
+}
@@ -0,0 +1,14 @@
+package signingKey
+
+import (
+	"context"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"time"
+)
+
+type Repository interface {
+	List(ctx context.Context, now time.Time) ([]domain.SigningKey, error)
+	Rotate(ctx context.Context, key domain.SigningKey, rotateAfter time.Time, retireAt time.Time) (bool, error)
+	DeleteRetired(ctx context.Context, now time.Time) (int64, error)
+	ResealKeys(ctx context.Context, targetVersion int, reseal ResealFunc) (int, error)
+}
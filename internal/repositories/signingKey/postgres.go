@@ -0,0 +1,174 @@
+package signingKey
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"time"
+)
+
+// ResealFunc seals the private key with the target master key version.
+type ResealFunc func(key domain.SigningKey) (string, error)
+
+type PostgresRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRepository(dataSourceName string) (*PostgresRepository, error) {
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	// Check the connection
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &PostgresRepository{db}, nil
+}
+
+// List returns keys which aren't retired at now, including ones which aren't active yet.
+func (s *PostgresRepository) List(ctx context.Context, now time.Time) ([]domain.SigningKey, error) {
+	const op = "repositories.signingKey.postgres.List"
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, algorithm, private_key, key_version, created_at, activates_at, retires_at
+		FROM signing_keys WHERE retires_at IS NULL OR retires_at > $1 ORDER BY activates_at`,
+		now.UnixMilli())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var keys []domain.SigningKey
+	for rows.Next() {
+		var key domain.SigningKey
+		var createdAt, activatesAt int64
+		var retiresAt sql.NullInt64
+		err := rows.Scan(&key.ID, &key.Algorithm, &key.PrivateKey, &key.KeyVersion, &createdAt, &activatesAt, &retiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		key.CreatedAt = time.UnixMilli(createdAt)
+		key.ActivatesAt = time.UnixMilli(activatesAt)
+		if retiresAt.Valid {
+			t := time.UnixMilli(retiresAt.Int64)
+			key.RetiresAt = &t
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return keys, nil
+}
+
+// Rotate adds the key unless another key activating after rotateAfter exists, so concurrent
+// rotations by several instances add one key. Keys it replaces retire at retireAt.
+// It reports whether the key was added.
+func (s *PostgresRepository) Rotate(ctx context.Context, key domain.SigningKey, rotateAfter time.Time, retireAt time.Time) (bool, error) {
+	const op = "repositories.signingKey.postgres.Rotate"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "LOCK TABLE signing_keys IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	var exists bool
+	err = tx.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM signing_keys WHERE activates_at > $1)", rotateAfter.UnixMilli(),
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	if exists {
+		return false, nil
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE signing_keys SET retires_at = $1 WHERE retires_at IS NULL", retireAt.UnixMilli())
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO signing_keys (id, algorithm, private_key, key_version, created_at, activates_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		key.ID, key.Algorithm, key.PrivateKey, key.KeyVersion, key.CreatedAt.UnixMilli(), key.ActivatesAt.UnixMilli())
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return true, nil
+}
+
+// ResealKeys seals private keys which aren't sealed with targetVersion yet.
+// Returns the number of resealed keys.
+func (s *PostgresRepository) ResealKeys(ctx context.Context, targetVersion int, reseal ResealFunc) (int, error) {
+	const op = "repositories.signingKey.postgres.ResealKeys"
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx,
+		"SELECT id, algorithm, private_key, key_version FROM signing_keys WHERE key_version <> $1 FOR UPDATE", targetVersion)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	var keys []domain.SigningKey
+	for rows.Next() {
+		var key domain.SigningKey
+		if err := rows.Scan(&key.ID, &key.Algorithm, &key.PrivateKey, &key.KeyVersion); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		keys = append(keys, key)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, key := range keys {
+		sealed, err := reseal(key)
+		if err != nil {
+			return 0, fmt.Errorf("%s: key %s: %w", op, key.ID, err)
+		}
+		_, err = tx.ExecContext(ctx,
+			"UPDATE signing_keys SET private_key = $2, key_version = $3 WHERE id = $1", key.ID, sealed, targetVersion)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return len(keys), nil
+}
+
+// DeleteRetired removes keys retired by now, tokens they signed have expired.
+func (s *PostgresRepository) DeleteRetired(ctx context.Context, now time.Time) (int64, error) {
+	const op = "repositories.signingKey.postgres.DeleteRetired"
+
+	res, err := s.db.ExecContext(ctx, "DELETE FROM signing_keys WHERE retires_at <= $1", now.UnixMilli())
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return deleted, nil
+}
@@ -4,9 +4,35 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"github.com/s0vunia/password-manager/internal/domain"
+	"time"
 )
 
 type Repository interface {
-	Create(ctx context.Context, login string, passHash []byte) (uuid.UUID, error)
-	Get(ctx context.Context, login string) (domain.User, error)
+	Create(ctx context.Context, login string, passHash []byte, kdf *domain.KDFParams) (uuid.UUID, error)
+	Get(ctx context.Context, login string) (*domain.User, error)
+	GetKey(ctx context.Context, userId uuid.UUID) (*domain.UserKey, error)
+	SaveKey(ctx context.Context, key domain.UserKey) error
+	IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error)
+	IsAdmin(ctx context.Context, userId uuid.UUID) (bool, error)
+	SetAdmin(ctx context.Context, login string, admin bool) error
+	GetTOTP(ctx context.Context, userId uuid.UUID) (*domain.UserTOTP, error)
+	SaveTOTPSecret(ctx context.Context, userId uuid.UUID, secret string) error
+	EnableTOTP(ctx context.Context, userId uuid.UUID, counter uint64, codeHashes [][]byte) error
+	DisableTOTP(ctx context.Context, userId uuid.UUID) error
+	UseTOTPCounter(ctx context.Context, userId uuid.UUID, counter uint64) (bool, error)
+	GetRecoveryCodes(ctx context.Context, userId uuid.UUID) ([]domain.RecoveryCode, error)
+	UseRecoveryCode(ctx context.Context, codeId uuid.UUID) (bool, error)
+	CreateLoginChallenge(ctx context.Context, tokenHash []byte, userId uuid.UUID, appId int, webAuthnChallenge []byte, ttl time.Duration) error
+	AttemptLoginChallenge(ctx context.Context, tokenHash []byte) (*domain.LoginChallenge, error)
+	DeleteLoginChallenge(ctx context.Context, challengeId uuid.UUID) error
+	SaveWebAuthnRegistration(ctx context.Context, userId uuid.UUID, challenge []byte, ttl time.Duration) error
+	TakeWebAuthnRegistration(ctx context.Context, userId uuid.UUID) ([]byte, error)
+	CreateWebAuthnCredential(ctx context.Context, credential domain.WebAuthnCredential, codeHashes [][]byte) (uuid.UUID, error)
+	GetWebAuthnCredentials(ctx context.Context, userId uuid.UUID) ([]domain.WebAuthnCredential, error)
+	GetWebAuthnCredential(ctx context.Context, userId uuid.UUID, credentialId []byte) (*domain.WebAuthnCredential, error)
+	UpdateWebAuthnSignCount(ctx context.Context, id uuid.UUID, previous, count uint32) (bool, error)
+	DeleteWebAuthnCredential(ctx context.Context, userId uuid.UUID, id uuid.UUID) error
+	StartKeyRotation(ctx context.Context, targetVersion int) (*domain.KeyRotation, error)
+	RewrapKeys(ctx context.Context, rotation domain.KeyRotation, batchSize int, rewrap RewrapFunc) (int, error)
+	CompleteKeyRotation(ctx context.Context, rotationId int64) error
 }
@@ -31,14 +31,26 @@ func NewPostgresRepository(dataSourceName string) (*PostgresRepository, error) {
 	return &PostgresRepository{db}, nil
 }
 
-func (p *PostgresRepository) Create(ctx context.Context, login string, passHash []byte) (uuid.UUID, error) {
+// Create saves new user. kdf is nil for users whose vault is encrypted server-side.
+func (p *PostgresRepository) Create(ctx context.Context, login string, passHash []byte, kdf *domain.KDFParams) (uuid.UUID, error) {
 	const op = "repositories.user.postgres.Create"
 	var lastInsertId uuid.UUID
-	stmt, err := p.db.Prepare("INSERT INTO users(id, login, pass_hash) VALUES (gen_random_uuid(), $1, $2) RETURNING id")
+	stmt, err := p.db.Prepare(`INSERT INTO users(id, login, pass_hash, kdf_algorithm, kdf_salt, kdf_memory, kdf_iterations, kdf_parallelism)
+		VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7) RETURNING id`)
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
 	}
-	row := stmt.QueryRowContext(ctx, login, string(passHash))
+	var algorithm sql.NullString
+	var salt []byte
+	var memory, iterations, parallelism sql.NullInt64
+	if kdf != nil {
+		algorithm = sql.NullString{String: kdf.Algorithm, Valid: true}
+		salt = kdf.Salt
+		memory = sql.NullInt64{Int64: int64(kdf.Memory), Valid: true}
+		iterations = sql.NullInt64{Int64: int64(kdf.Iterations), Valid: true}
+		parallelism = sql.NullInt64{Int64: int64(kdf.Parallelism), Valid: true}
+	}
+	row := stmt.QueryRowContext(ctx, login, string(passHash), algorithm, salt, memory, iterations, parallelism)
 	err = row.Scan(&lastInsertId)
 	if err != nil {
 		var pqErr *pgconn.PgError
@@ -53,7 +65,9 @@ func (p *PostgresRepository) Create(ctx context.Context, login string, passHash
 func (s *PostgresRepository) Get(ctx context.Context, login string) (*domain.User, error) {
 	const op = "repositories.user.postgres.Get"
 
-	stmt, err := s.db.Prepare("SELECT id, login, pass_hash FROM users WHERE login = $1")
+	stmt, err := s.db.Prepare(`SELECT id, login, pass_hash, COALESCE(wrapped_key, ''), key_version,
+		kdf_algorithm, kdf_salt, kdf_memory, kdf_iterations, kdf_parallelism, totp_enabled,
+		EXISTS (SELECT 1 FROM webauthn_credentials WHERE user_id = users.id) FROM users WHERE login = $1`)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -61,12 +75,119 @@ func (s *PostgresRepository) Get(ctx context.Context, login string) (*domain.Use
 	row := stmt.QueryRowContext(ctx, login)
 
 	var user domain.User
-	err = row.Scan(&user.ID, &user.Login, &user.PassHash)
+	var algorithm sql.NullString
+	var salt []byte
+	var memory, iterations, parallelism sql.NullInt64
+	err = row.Scan(&user.ID, &user.Login, &user.PassHash, &user.WrappedKey, &user.KeyVersion,
+		&algorithm, &salt, &memory, &iterations, &parallelism, &user.TOTPEnabled, &user.WebAuthnEnabled)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("%s: %w", op, repositories.ErrUserNotFound)
 		}
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	if algorithm.Valid {
+		user.KDF = &domain.KDFParams{
+			Algorithm:   algorithm.String,
+			Salt:        salt,
+			Memory:      uint32(memory.Int64),
+			Iterations:  uint32(iterations.Int64),
+			Parallelism: uint8(parallelism.Int64),
+		}
+	}
 	return &user, nil
 }
+
+// GetKey returns wrapped data encryption key of the user.
+// Empty WrappedKey means the key hasn't been generated yet.
+func (p *PostgresRepository) GetKey(ctx context.Context, userId uuid.UUID) (*domain.UserKey, error) {
+	const op = "repositories.user.postgres.GetKey"
+
+	stmt, err := p.db.Prepare("SELECT id, COALESCE(wrapped_key, ''), key_version FROM users WHERE id = $1")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var key domain.UserKey
+	err = stmt.QueryRowContext(ctx, userId).Scan(&key.UserId, &key.WrappedKey, &key.Version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrUserNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &key, nil
+}
+
+// IsZeroKnowledge reports whether the user registered in zero-knowledge mode,
+// vault items of such users are encrypted on the client and opaque to the server.
+func (p *PostgresRepository) IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error) {
+	const op = "repositories.user.postgres.IsZeroKnowledge"
+
+	var zk bool
+	err := p.db.QueryRowContext(ctx, "SELECT kdf_algorithm IS NOT NULL FROM users WHERE id = $1", userId).Scan(&zk)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, fmt.Errorf("%s: %w", op, repositories.ErrUserNotFound)
+		}
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return zk, nil
+}
+
+// IsAdmin reports whether the user may call admin operations.
+func (p *PostgresRepository) IsAdmin(ctx context.Context, userId uuid.UUID) (bool, error) {
+	const op = "repositories.user.postgres.IsAdmin"
+
+	var admin bool
+	err := p.db.QueryRowContext(ctx, "SELECT is_admin FROM users WHERE id = $1", userId).Scan(&admin)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, fmt.Errorf("%s: %w", op, repositories.ErrUserNotFound)
+		}
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return admin, nil
+}
+
+// SetAdmin grants or revokes admin operations of the user with the login.
+func (p *PostgresRepository) SetAdmin(ctx context.Context, login string, admin bool) error {
+	const op = "repositories.user.postgres.SetAdmin"
+
+	res, err := p.db.ExecContext(ctx, "UPDATE users SET is_admin = $2 WHERE login = $1", login, admin)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if updated == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrUserNotFound)
+	}
+	return nil
+}
+
+// SaveKey stores wrapped data encryption key of the user unless one already exists.
+// It returns repositories.ErrUserKeyExists when another key was stored first.
+func (p *PostgresRepository) SaveKey(ctx context.Context, key domain.UserKey) error {
+	const op = "repositories.user.postgres.SaveKey"
+
+	stmt, err := p.db.Prepare("UPDATE users SET wrapped_key = $2, key_version = $3 WHERE id = $1 AND wrapped_key IS NULL")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := stmt.ExecContext(ctx, key.UserId, key.WrappedKey, key.Version)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrUserKeyExists)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/s0vunia/password-manager/internal/domain"
+)
+
+// RewrapFunc re-seals user key with the target master key version.
+type RewrapFunc func(key domain.UserKey) (string, error)
+
+// StartKeyRotation returns unfinished rotation to targetVersion, so an interrupted
+// rotation is resumed, or registers a new one.
+func (p *PostgresRepository) StartKeyRotation(ctx context.Context, targetVersion int) (*domain.KeyRotation, error) {
+	const op = "repositories.user.postgres.StartKeyRotation"
+
+	var rotation domain.KeyRotation
+	err := p.db.QueryRowContext(ctx,
+		"SELECT id, target_version, rewrapped, started_at FROM key_rotations WHERE target_version = $1 AND completed_at IS NULL ORDER BY id DESC LIMIT 1",
+		targetVersion,
+	).Scan(&rotation.ID, &rotation.TargetVersion, &rotation.Rewrapped, &rotation.StartedAt)
+	if err == nil {
+		return &rotation, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = p.db.QueryRowContext(ctx,
+		"INSERT INTO key_rotations(target_version) VALUES ($1) RETURNING id, target_version, rewrapped, started_at",
+		targetVersion,
+	).Scan(&rotation.ID, &rotation.TargetVersion, &rotation.Rewrapped, &rotation.StartedAt)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &rotation, nil
+}
+
+// RewrapKeys re-seals up to batchSize user keys that aren't on the target version yet.
+// The batch and the rotation progress are committed in one transaction,
+// so a crash loses at most the current batch. Returns number of re-wrapped keys,
+// zero means the rotation is done.
+func (p *PostgresRepository) RewrapKeys(ctx context.Context, rotation domain.KeyRotation, batchSize int, rewrap RewrapFunc) (int, error) {
+	const op = "repositories.user.postgres.RewrapKeys"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx,
+		"SELECT id, wrapped_key, key_version FROM users WHERE wrapped_key IS NOT NULL AND key_version <> $1 ORDER BY id LIMIT $2 FOR UPDATE SKIP LOCKED",
+		rotation.TargetVersion, batchSize,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	var keys []domain.UserKey
+	for rows.Next() {
+		var key domain.UserKey
+		if err := rows.Scan(&key.UserId, &key.WrappedKey, &key.Version); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		keys = append(keys, key)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	stmt, err := tx.PrepareContext(ctx, "UPDATE users SET wrapped_key = $2, key_version = $3 WHERE id = $1")
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer stmt.Close()
+
+	for _, key := range keys {
+		wrappedKey, err := rewrap(key)
+		if err != nil {
+			return 0, fmt.Errorf("%s: user %s: %w", op, key.UserId, err)
+		}
+		if _, err := stmt.ExecContext(ctx, key.UserId, wrappedKey, rotation.TargetVersion); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE key_rotations SET rewrapped = rewrapped + $2 WHERE id = $1", rotation.ID, len(keys))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return len(keys), nil
+}
+
+// CompleteKeyRotation marks rotation as finished.
+func (p *PostgresRepository) CompleteKeyRotation(ctx context.Context, rotationId int64) error {
+	const op = "repositories.user.postgres.CompleteKeyRotation"
+
+	_, err := p.db.ExecContext(ctx, "UPDATE key_rotations SET completed_at = now() WHERE id = $1", rotationId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
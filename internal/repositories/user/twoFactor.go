@@ -0,0 +1,236 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"time"
+)
+
+func (p *PostgresRepository) GetTOTP(ctx context.Context, userId uuid.UUID) (*domain.UserTOTP, error) {
+	const op = "repositories.user.postgres.GetTOTP"
+
+	totp := domain.UserTOTP{UserId: userId}
+	err := p.db.QueryRowContext(ctx,
+		"SELECT login, COALESCE(totp_secret, ''), totp_enabled FROM users WHERE id = $1", userId,
+	).Scan(&totp.Login, &totp.Secret, &totp.Enabled)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrUserNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &totp, nil
+}
+
+// SaveTOTPSecret stores a pending secret replacing the previous pending one.
+// It returns repositories.ErrTOTPEnabled if the user already confirmed a secret.
+func (p *PostgresRepository) SaveTOTPSecret(ctx context.Context, userId uuid.UUID, secret string) error {
+	const op = "repositories.user.postgres.SaveTOTPSecret"
+
+	res, err := p.db.ExecContext(ctx,
+		"UPDATE users SET totp_secret = $2, totp_last_counter = NULL WHERE id = $1 AND NOT totp_enabled",
+		userId, secret)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrTOTPEnabled)
+	}
+	return nil
+}
+
+// EnableTOTP turns on the pending secret, the code of counter can't be used again.
+// Recovery codes are replaced with codeHashes.
+func (p *PostgresRepository) EnableTOTP(ctx context.Context, userId uuid.UUID, counter uint64, codeHashes [][]byte) error {
+	const op = "repositories.user.postgres.EnableTOTP"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE users SET totp_enabled = true, totp_last_counter = $2 WHERE id = $1 AND totp_secret IS NOT NULL AND NOT totp_enabled",
+		userId, int64(counter))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrTOTPNotEnrolled)
+	}
+
+	if err := replaceRecoveryCodes(ctx, tx, userId, codeHashes); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// DisableTOTP removes the secret of the user. Recovery codes are removed too
+// unless the user still has security keys.
+func (p *PostgresRepository) DisableTOTP(ctx context.Context, userId uuid.UUID) error {
+	const op = "repositories.user.postgres.DisableTOTP"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE users SET totp_secret = NULL, totp_enabled = false, totp_last_counter = NULL WHERE id = $1", userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	_, err = tx.ExecContext(ctx,
+		"DELETE FROM recovery_codes WHERE user_id = $1 AND NOT EXISTS (SELECT 1 FROM webauthn_credentials WHERE user_id = $1)",
+		userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// UseTOTPCounter marks the code of counter as used and reports false
+// if it or a later one was used already, so a code can't be replayed.
+func (p *PostgresRepository) UseTOTPCounter(ctx context.Context, userId uuid.UUID, counter uint64) (bool, error) {
+	const op = "repositories.user.postgres.UseTOTPCounter"
+
+	res, err := p.db.ExecContext(ctx,
+		"UPDATE users SET totp_last_counter = $2 WHERE id = $1 AND (totp_last_counter IS NULL OR totp_last_counter < $2)",
+		userId, int64(counter))
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return affected == 1, nil
+}
+
+// GetRecoveryCodes returns unused recovery codes of the user.
+func (p *PostgresRepository) GetRecoveryCodes(ctx context.Context, userId uuid.UUID) ([]domain.RecoveryCode, error) {
+	const op = "repositories.user.postgres.GetRecoveryCodes"
+
+	rows, err := p.db.QueryContext(ctx, "SELECT id, code_hash FROM recovery_codes WHERE user_id = $1 AND used_at IS NULL", userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var codes []domain.RecoveryCode
+	for rows.Next() {
+		var code domain.RecoveryCode
+		var hash string
+		if err := rows.Scan(&code.ID, &hash); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		code.Hash = []byte(hash)
+		codes = append(codes, code)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return codes, nil
+}
+
+// UseRecoveryCode marks the code as used and reports false if it was used concurrently.
+func (p *PostgresRepository) UseRecoveryCode(ctx context.Context, codeId uuid.UUID) (bool, error) {
+	const op = "repositories.user.postgres.UseRecoveryCode"
+
+	res, err := p.db.ExecContext(ctx, "UPDATE recovery_codes SET used_at = now() WHERE id = $1 AND used_at IS NULL", codeId)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return affected == 1, nil
+}
+
+// CreateLoginChallenge stores the challenge valid for ttl and drops expired ones.
+// webAuthnChallenge is nil if the user has no security keys.
+func (p *PostgresRepository) CreateLoginChallenge(
+	ctx context.Context,
+	tokenHash []byte,
+	userId uuid.UUID,
+	appId int,
+	webAuthnChallenge []byte,
+	ttl time.Duration,
+) error {
+	const op = "repositories.user.postgres.CreateLoginChallenge"
+
+	if _, err := p.db.ExecContext(ctx, "DELETE FROM login_challenges WHERE expires_at < now()"); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	_, err := p.db.ExecContext(ctx,
+		`INSERT INTO login_challenges (token_hash, user_id, app_id, webauthn_challenge, expires_at)
+		VALUES ($1, $2, $3, $4, now() + make_interval(secs => $5))`,
+		tokenHash, userId, appId, webAuthnChallenge, ttl.Seconds())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// AttemptLoginChallenge counts an attempt to answer the challenge and returns it with the attempt included.
+func (p *PostgresRepository) AttemptLoginChallenge(ctx context.Context, tokenHash []byte) (*domain.LoginChallenge, error) {
+	const op = "repositories.user.postgres.AttemptLoginChallenge"
+
+	var challenge domain.LoginChallenge
+	err := p.db.QueryRowContext(ctx,
+		`UPDATE login_challenges SET attempts = attempts + 1 WHERE token_hash = $1 AND expires_at > now()
+		RETURNING id, user_id, app_id, attempts, webauthn_challenge`,
+		tokenHash,
+	).Scan(&challenge.ID, &challenge.UserId, &challenge.AppId, &challenge.Attempts, &challenge.WebAuthnChallenge)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrChallengeNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &challenge, nil
+}
+
+func (p *PostgresRepository) DeleteLoginChallenge(ctx context.Context, challengeId uuid.UUID) error {
+	const op = "repositories.user.postgres.DeleteLoginChallenge"
+
+	if _, err := p.db.ExecContext(ctx, "DELETE FROM login_challenges WHERE id = $1", challengeId); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx *sql.Tx, userId uuid.UUID, codeHashes [][]byte) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM recovery_codes WHERE user_id = $1", userId); err != nil {
+		return err
+	}
+	for _, hash := range codeHashes {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO recovery_codes (user_id, code_hash) VALUES ($1, $2)", userId, string(hash)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
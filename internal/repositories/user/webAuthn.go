@@ -0,0 +1,200 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"time"
+)
+
+// SaveWebAuthnRegistration stores the challenge of a registration ceremony valid for ttl,
+// it replaces the pending registration of the user.
+func (p *PostgresRepository) SaveWebAuthnRegistration(ctx context.Context, userId uuid.UUID, challenge []byte, ttl time.Duration) error {
+	const op = "repositories.user.postgres.SaveWebAuthnRegistration"
+
+	_, err := p.db.ExecContext(ctx,
+		`INSERT INTO webauthn_registrations (user_id, challenge, expires_at) VALUES ($1, $2, now() + make_interval(secs => $3))
+		ON CONFLICT (user_id) DO UPDATE SET challenge = EXCLUDED.challenge, expires_at = EXCLUDED.expires_at`,
+		userId, challenge, ttl.Seconds())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// TakeWebAuthnRegistration returns the challenge of the pending registration and deletes it,
+// so it can be answered once.
+func (p *PostgresRepository) TakeWebAuthnRegistration(ctx context.Context, userId uuid.UUID) ([]byte, error) {
+	const op = "repositories.user.postgres.TakeWebAuthnRegistration"
+
+	var challenge []byte
+	err := p.db.QueryRowContext(ctx,
+		"DELETE FROM webauthn_registrations WHERE user_id = $1 AND expires_at > now() RETURNING challenge", userId,
+	).Scan(&challenge)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrChallengeNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return challenge, nil
+}
+
+// CreateWebAuthnCredential stores the credential. Recovery codes of the user
+// are replaced with codeHashes unless they are nil.
+func (p *PostgresRepository) CreateWebAuthnCredential(ctx context.Context, credential domain.WebAuthnCredential, codeHashes [][]byte) (uuid.UUID, error) {
+	const op = "repositories.user.postgres.CreateWebAuthnCredential"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var id uuid.UUID
+	err = tx.QueryRowContext(ctx,
+		`INSERT INTO webauthn_credentials (user_id, credential_id, public_key, sign_count, aaguid, name)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		credential.UserId, credential.CredentialID, credential.PublicKey, int64(credential.SignCount), credential.AAGUID, credential.Name,
+	).Scan(&id)
+	if err != nil {
+		var pqErr *pgconn.PgError
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return uuid.UUID{}, fmt.Errorf("%s: %w", op, repositories.ErrWebAuthnCredentialExists)
+		}
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if codeHashes != nil {
+		if err := replaceRecoveryCodes(ctx, tx, credential.UserId, codeHashes); err != nil {
+			return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (p *PostgresRepository) GetWebAuthnCredentials(ctx context.Context, userId uuid.UUID) ([]domain.WebAuthnCredential, error) {
+	const op = "repositories.user.postgres.GetWebAuthnCredentials"
+
+	rows, err := p.db.QueryContext(ctx,
+		`SELECT id, user_id, credential_id, public_key, sign_count, aaguid, name, created_at, last_used_at
+		FROM webauthn_credentials WHERE user_id = $1 ORDER BY created_at`, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+	var credentials []domain.WebAuthnCredential
+	for rows.Next() {
+		credential, err := scanWebAuthnCredential(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		credentials = append(credentials, *credential)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return credentials, nil
+}
+
+// GetWebAuthnCredential returns the user's credential by the id the authenticator assigned to it.
+func (p *PostgresRepository) GetWebAuthnCredential(ctx context.Context, userId uuid.UUID, credentialId []byte) (*domain.WebAuthnCredential, error) {
+	const op = "repositories.user.postgres.GetWebAuthnCredential"
+
+	row := p.db.QueryRowContext(ctx,
+		`SELECT id, user_id, credential_id, public_key, sign_count, aaguid, name, created_at, last_used_at
+		FROM webauthn_credentials WHERE user_id = $1 AND credential_id = $2`, userId, credentialId)
+	credential, err := scanWebAuthnCredential(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, repositories.ErrWebAuthnCredentialNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return credential, nil
+}
+
+// UpdateWebAuthnSignCount stores the signature counter of a successful assertion.
+// It reports false if the counter was changed concurrently, so an assertion can't be replayed.
+func (p *PostgresRepository) UpdateWebAuthnSignCount(ctx context.Context, id uuid.UUID, previous, count uint32) (bool, error) {
+	const op = "repositories.user.postgres.UpdateWebAuthnSignCount"
+
+	res, err := p.db.ExecContext(ctx,
+		"UPDATE webauthn_credentials SET sign_count = $3, last_used_at = now() WHERE id = $1 AND sign_count = $2",
+		id, int64(previous), int64(count))
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return affected == 1, nil
+}
+
+// DeleteWebAuthnCredential removes the credential. Recovery codes are removed
+// with the last security key unless the user has TOTP enabled.
+func (p *PostgresRepository) DeleteWebAuthnCredential(ctx context.Context, userId uuid.UUID, id uuid.UUID) error {
+	const op = "repositories.user.postgres.DeleteWebAuthnCredential"
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM webauthn_credentials WHERE id = $1 AND user_id = $2", id, userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, repositories.ErrWebAuthnCredentialNotFound)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM recovery_codes WHERE user_id = $1
+		AND NOT EXISTS (SELECT 1 FROM webauthn_credentials WHERE user_id = $1)
+		AND NOT EXISTS (SELECT 1 FROM users WHERE id = $1 AND totp_enabled)`,
+		userId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWebAuthnCredential(row scanner) (*domain.WebAuthnCredential, error) {
+	var credential domain.WebAuthnCredential
+	var signCount int64
+	var lastUsedAt sql.NullTime
+	err := row.Scan(&credential.ID, &credential.UserId, &credential.CredentialID, &credential.PublicKey,
+		&signCount, &credential.AAGUID, &credential.Name, &credential.CreatedAt, &lastUsedAt)
+	if err != nil {
+		return nil, err
+	}
+	credential.SignCount = uint32(signCount)
+	if lastUsedAt.Valid {
+		credential.LastUsedAt = &lastUsedAt.Time
+	}
+	return &credential, nil
+}
@@ -0,0 +1,224 @@
+package apiToken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	apiTokenRepo "github.com/s0vunia/password-manager/internal/repositories/apiToken"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	tokenSize     = 32
+	maxNameLength = 100
+	// touchInterval is how often last use of a token is recorded.
+	touchInterval = time.Minute
+)
+
+var (
+	ErrInvalidName   = errors.New("token name must be 1 to 100 characters")
+	ErrInvalidScopes = errors.New("token scopes must be vault:read and/or vault:write")
+	ErrInvalidTTL    = errors.New("token expiry is out of the allowed range")
+	// ErrFolderScopeReadOnly is returned for folder-limited tokens with the write scope,
+	// only reads are checked against the folder.
+	ErrFolderScopeReadOnly = errors.New("token limited to a folder can only have the vault:read scope")
+	ErrInvalidToken        = errors.New("api token is invalid or expired")
+)
+
+// IAPITokenService manages personal access tokens users give to automation, e.g. CI jobs.
+// A token is returned once on creation and stored hashed.
+type IAPITokenService interface {
+	CreateToken(
+		ctx context.Context,
+		userId uuid.UUID,
+		name string,
+		scopes []string,
+		folderId *uuid.UUID,
+		ttl time.Duration,
+	) (string, domain.APIToken, error)
+	ListTokens(ctx context.Context, userId uuid.UUID) ([]domain.APIToken, error)
+	RevokeToken(ctx context.Context, userId uuid.UUID, id uuid.UUID) error
+	Authenticate(ctx context.Context, token string) (*domain.APIToken, error)
+}
+
+type FolderProvider interface {
+	List(ctx context.Context, userId uuid.UUID) ([]*domain.Folder, error)
+}
+
+type Service struct {
+	log     *slog.Logger
+	tokens  apiTokenRepo.Repository
+	folders FolderProvider
+	maxTTL  time.Duration
+}
+
+func New(
+	log *slog.Logger,
+	tokens apiTokenRepo.Repository,
+	folders FolderProvider,
+	maxTTL time.Duration,
+) *Service {
+	return &Service{
+		log:     log,
+		tokens:  tokens,
+		folders: folders,
+		maxTTL:  maxTTL,
+	}
+}
+
+// CreateToken issues a token of the user valid for ttl, which can't exceed the configured maximum.
+func (s *Service) CreateToken(
+	ctx context.Context,
+	userId uuid.UUID,
+	name string,
+	scopes []string,
+	folderId *uuid.UUID,
+	ttl time.Duration,
+) (string, domain.APIToken, error) {
+	const op = "APITokenService.CreateToken"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to create api token")
+
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return "", domain.APIToken{}, fmt.Errorf("%s: %w", op, ErrInvalidName)
+	}
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return "", domain.APIToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if ttl <= 0 || ttl > s.maxTTL {
+		return "", domain.APIToken{}, fmt.Errorf("%s: %w", op, ErrInvalidTTL)
+	}
+	if folderId != nil {
+		if slices.Contains(scopes, domain.APITokenScopeWrite) {
+			return "", domain.APIToken{}, fmt.Errorf("%s: %w", op, ErrFolderScopeReadOnly)
+		}
+		if err := s.checkFolder(ctx, userId, *folderId); err != nil {
+			log.Info("folder of the token is not found", sl.Err(err))
+
+			return "", domain.APIToken{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	raw := make([]byte, tokenSize)
+	if _, err := rand.Read(raw); err != nil {
+		log.Error("failed to generate api token", sl.Err(err))
+
+		return "", domain.APIToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+	secret := domain.APITokenPrefix + base64.RawURLEncoding.EncodeToString(raw)
+	hash := sha256.Sum256([]byte(secret))
+
+	token, err := s.tokens.Create(ctx, domain.APIToken{
+		UserId:   userId,
+		Name:     name,
+		Scopes:   scopes,
+		FolderId: folderId,
+	}, hash[:], ttl)
+	if err != nil {
+		log.Error("failed to save api token", sl.Err(err))
+
+		return "", domain.APIToken{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("api token created", slog.String("token", token.ID.String()))
+
+	return secret, token, nil
+}
+
+func (s *Service) ListTokens(ctx context.Context, userId uuid.UUID) ([]domain.APIToken, error) {
+	const op = "APITokenService.ListTokens"
+
+	tokens, err := s.tokens.List(ctx, userId)
+	if err != nil {
+		s.log.With(slog.String("op", op)).Error("failed to list api tokens", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return tokens, nil
+}
+
+func (s *Service) RevokeToken(ctx context.Context, userId uuid.UUID, id uuid.UUID) error {
+	const op = "APITokenService.RevokeToken"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("token", id.String()),
+	)
+
+	log.Info("attempting to revoke api token")
+
+	if err := s.tokens.Revoke(ctx, userId, id); err != nil {
+		log.Error("failed to revoke api token", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Authenticate returns the unexpired token and records its use.
+func (s *Service) Authenticate(ctx context.Context, token string) (*domain.APIToken, error) {
+	const op = "APITokenService.Authenticate"
+
+	hash := sha256.Sum256([]byte(token))
+	apiToken, err := s.tokens.GetByHash(ctx, hash[:])
+	if err != nil {
+		if errors.Is(err, repositories.ErrAPITokenNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	// Failing to record the use mustn't fail the request.
+	if err := s.tokens.Touch(ctx, apiToken.ID, touchInterval); err != nil {
+		s.log.With(slog.String("op", op)).Warn("failed to record api token use", sl.Err(err))
+	}
+	return apiToken, nil
+}
+
+func (s *Service) checkFolder(ctx context.Context, userId uuid.UUID, folderId uuid.UUID) error {
+	folders, err := s.folders.List(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, folder := range folders {
+		if folder.ID == folderId {
+			return nil
+		}
+	}
+	return repositories.ErrFolderNotFound
+}
+
+// normalizeScopes drops duplicates and rejects unknown scopes.
+func normalizeScopes(scopes []string) ([]string, error) {
+	var normalized []string
+	for _, scope := range scopes {
+		if scope != domain.APITokenScopeRead && scope != domain.APITokenScopeWrite {
+			return nil, ErrInvalidScopes
+		}
+		if !slices.Contains(normalized, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+	if len(normalized) == 0 {
+		return nil, ErrInvalidScopes
+	}
+	return normalized, nil
+}
@@ -0,0 +1,281 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	appRepo "github.com/s0vunia/password-manager/internal/repositories/app"
+	"log/slog"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	secretSize           = 32
+	maxNameLength        = 50
+	maxRedirectURIs      = 10
+	maxRedirectURILength = 2000
+)
+
+var (
+	ErrInvalidAppName     = errors.New("app name must be 1 to 50 characters")
+	ErrInvalidRedirectURI = errors.New("redirect uri must be an absolute https or loopback http url without fragment")
+)
+
+// IAppService manages apps users log in through. Client secrets are generated on the server,
+// returned once and only their hashes are stored.
+type IAppService interface {
+	App(ctx context.Context, appID int64) (domain.App, error)
+	VerifySecret(app domain.App, secret string) bool
+	RegisterApp(ctx context.Context, name string, redirectURIs []string) (domain.App, string, error)
+	ListApps(ctx context.Context) ([]domain.App, error)
+	RedirectURIs(ctx context.Context, appID int64) ([]string, error)
+	SetRedirectURIs(ctx context.Context, appID int64, uris []string) error
+	RotateAppSecret(ctx context.Context, appID int64) (string, error)
+	SetAppDisabled(ctx context.Context, appID int64, disabled bool) error
+	DeleteApp(ctx context.Context, appID int64) error
+}
+
+type Service struct {
+	log  *slog.Logger
+	apps appRepo.Repository
+}
+
+func New(
+	log *slog.Logger,
+	apps appRepo.Repository,
+) *Service {
+	return &Service{
+		log:  log,
+		apps: apps,
+	}
+}
+
+func (s *Service) App(ctx context.Context, appID int64) (domain.App, error) {
+	const op = "AppService.App"
+
+	app, err := s.apps.App(ctx, appID)
+	if err != nil {
+		return domain.App{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return app, nil
+}
+
+// VerifySecret reports whether secret is the client secret of the app, apps without one never match.
+func (s *Service) VerifySecret(app domain.App, secret string) bool {
+	if len(app.SecretHash) == 0 {
+		return false
+	}
+	return subtle.ConstantTimeCompare(hashSecret(secret), app.SecretHash) == 1
+}
+
+// RegisterApp creates the app with a new client secret and returns the secret, it can't be read later.
+func (s *Service) RegisterApp(ctx context.Context, name string, redirectURIs []string) (domain.App, string, error) {
+	const op = "AppService.RegisterApp"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("name", name),
+	)
+
+	log.Info("attempting to register app")
+
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return domain.App{}, "", fmt.Errorf("%s: %w", op, ErrInvalidAppName)
+	}
+	if err := validateRedirectURIs(redirectURIs); err != nil {
+		return domain.App{}, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		log.Error("failed to generate secret", sl.Err(err))
+
+		return domain.App{}, "", fmt.Errorf("%s: %w", op, err)
+	}
+	app, err := s.apps.Create(ctx, name, hashSecret(secret))
+	if err != nil {
+		log.Error("failed to create app", sl.Err(err))
+
+		return domain.App{}, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(redirectURIs) > 0 {
+		if err := s.apps.SetRedirectURIs(ctx, int64(app.ID), redirectURIs); err != nil {
+			log.Error("failed to save redirect uris", sl.Err(err))
+
+			return domain.App{}, "", fmt.Errorf("%s: %w", op, err)
+		}
+		app.RedirectURIs = redirectURIs
+	}
+
+	log.Info("app registered", slog.Int("app", app.ID))
+
+	return app, secret, nil
+}
+
+// ListApps returns all apps without hashes of their secrets.
+func (s *Service) ListApps(ctx context.Context) ([]domain.App, error) {
+	const op = "AppService.ListApps"
+
+	apps, err := s.apps.Apps(ctx)
+	if err != nil {
+		s.log.With(slog.String("op", op)).Error("failed to list apps", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for i := range apps {
+		apps[i].SecretHash = nil
+		apps[i].RedirectURIs, err = s.apps.RedirectURIs(ctx, int64(apps[i].ID))
+		if err != nil {
+			s.log.With(slog.String("op", op)).Error("failed to get redirect uris", sl.Err(err))
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return apps, nil
+}
+
+func (s *Service) RedirectURIs(ctx context.Context, appID int64) ([]string, error) {
+	const op = "AppService.RedirectURIs"
+
+	uris, err := s.apps.RedirectURIs(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return uris, nil
+}
+
+// SetRedirectURIs replaces URIs the app may receive authorization codes at.
+func (s *Service) SetRedirectURIs(ctx context.Context, appID int64, uris []string) error {
+	const op = "AppService.SetRedirectURIs"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int64("app", appID),
+	)
+
+	log.Info("attempting to set redirect uris")
+
+	if err := validateRedirectURIs(uris); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.apps.SetRedirectURIs(ctx, appID, uris); err != nil {
+		log.Error("failed to set redirect uris", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// RotateAppSecret replaces the client secret of the app and returns the new one,
+// the previous secret stops authenticating the app right away.
+func (s *Service) RotateAppSecret(ctx context.Context, appID int64) (string, error) {
+	const op = "AppService.RotateAppSecret"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int64("app", appID),
+	)
+
+	log.Info("attempting to rotate app secret")
+
+	secret, err := newSecret()
+	if err != nil {
+		log.Error("failed to generate secret", sl.Err(err))
+
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.apps.UpdateSecret(ctx, appID, hashSecret(secret)); err != nil {
+		log.Error("failed to update secret", sl.Err(err))
+
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return secret, nil
+}
+
+// SetAppDisabled disables or enables the app, users can't log in through a disabled app
+// or refresh its sessions, and access tokens it issued are rejected until it's enabled again.
+func (s *Service) SetAppDisabled(ctx context.Context, appID int64, disabled bool) error {
+	const op = "AppService.SetAppDisabled"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int64("app", appID),
+		slog.Bool("disabled", disabled),
+	)
+
+	log.Info("attempting to change app state")
+
+	if err := s.apps.SetDisabled(ctx, appID, disabled); err != nil {
+		log.Error("failed to change app state", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *Service) DeleteApp(ctx context.Context, appID int64) error {
+	const op = "AppService.DeleteApp"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int64("app", appID),
+	)
+
+	log.Info("attempting to delete app")
+
+	if err := s.apps.Delete(ctx, appID); err != nil {
+		log.Error("failed to delete app", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// validateRedirectURIs accepts https URLs and http ones of loopback hosts, which
+// local tools listen on, as recommended for native apps by RFC 8252.
+func validateRedirectURIs(uris []string) error {
+	if len(uris) > maxRedirectURIs {
+		return ErrInvalidRedirectURI
+	}
+	for _, uri := range uris {
+		u, err := url.Parse(uri)
+		if err != nil || len(uri) > maxRedirectURILength || u.Host == "" || u.Fragment != "" || u.User != nil {
+			return ErrInvalidRedirectURI
+		}
+		switch u.Scheme {
+		case "https":
+		case "http":
+			if host := u.Hostname(); host != "localhost" && host != "127.0.0.1" && host != "::1" {
+				return ErrInvalidRedirectURI
+			}
+		default:
+			return ErrInvalidRedirectURI
+		}
+	}
+	return nil
+}
+
+func newSecret() (string, error) {
+	raw := make([]byte, secretSize)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// hashSecret hashes a client secret for storage, secrets are random 256-bit values,
+// so a fast hash doesn't make guessing them any easier.
+func hashSecret(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
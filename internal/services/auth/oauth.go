@@ -0,0 +1,336 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/jwt"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	OAuthInvalidRequest          = "invalid_request"
+	OAuthInvalidGrant            = "invalid_grant"
+	OAuthInvalidScope            = "invalid_scope"
+	OAuthUnsupportedResponseType = "unsupported_response_type"
+	OAuthUnsupportedGrantType    = "unsupported_grant_type"
+
+	codeChallengeMethodS256 = "S256"
+	// Verifiers are 43 to 128 characters long, see RFC 7636.
+	minCodeVerifierLength = 43
+	maxCodeVerifierLength = 128
+)
+
+var (
+	ErrOAuthDisabled      = errors.New("oauth provider mode is disabled")
+	ErrInvalidClient      = errors.New("client is unknown, disabled or failed to authenticate")
+	ErrInvalidRedirectURI = errors.New("redirect uri is not registered for the client")
+	ErrInvalidAccessToken = errors.New("access token is invalid, expired or revoked")
+	ErrInsufficientScope  = errors.New("access token wasn't granted the openid scope")
+)
+
+var supportedScopes = []string{domain.ScopeOpenID, domain.ScopeProfile}
+
+// OAuthOptions configure the OAuth 2.0 / OpenID Connect provider mode, it's enabled
+// if Issuer, the public URL of the HTTP server, is set. Codes are exchanged within CodeTTL.
+type OAuthOptions struct {
+	Issuer  string
+	CodeTTL time.Duration
+}
+
+type OAuthStore interface {
+	CreateCode(ctx context.Context, codeHash []byte, code domain.AuthorizationCode, ttl time.Duration) error
+	TakeCode(ctx context.Context, codeHash []byte) (*domain.AuthorizationCode, error)
+}
+
+// OAuthError is an error the client is told about by its RFC 6749 code,
+// through the redirect URI of an authorization request or the token response.
+type OAuthError struct {
+	Code        string
+	Description string
+}
+
+func (e *OAuthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+// Authorize issues an authorization code of the code flow with PKCE to the user of the access token.
+// Unknown clients and redirect URIs not registered for the client are plain errors,
+// the user must not be redirected then. Other invalid requests are OAuthError.
+func (a *Auth) Authorize(ctx context.Context, accessToken string, req domain.AuthorizationRequest) (string, error) {
+	const op = "Auth.Authorize"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("client", req.ClientId),
+	)
+
+	log.Info("attempting to authorize client")
+
+	if a.oauth.Issuer == "" {
+		return "", fmt.Errorf("%s: %w", op, ErrOAuthDisabled)
+	}
+	app, err := a.oauthClient(ctx, req.ClientId)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	uris, err := a.appProvider.RedirectURIs(ctx, int64(app.ID))
+	if err != nil {
+		log.Error("failed to get redirect uris", sl.Err(err))
+
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if !slices.Contains(uris, req.RedirectURI) {
+		log.Info("redirect uri is not registered", slog.String("redirect_uri", req.RedirectURI))
+
+		return "", fmt.Errorf("%s: %w", op, ErrInvalidRedirectURI)
+	}
+
+	claims, err := a.verifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if _, scoped := claims["scope"]; scoped {
+		return "", fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+	userId, err := uuid.Parse(fmt.Sprint(claims["uid"]))
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+	login, _ := claims["login"].(string)
+
+	if req.ResponseType != "code" {
+		return "", fmt.Errorf("%s: %w", op, &OAuthError{OAuthUnsupportedResponseType, "only the code response type is supported"})
+	}
+	scopes := strings.Fields(req.Scope)
+	if !slices.Contains(scopes, domain.ScopeOpenID) {
+		return "", fmt.Errorf("%s: %w", op, &OAuthError{OAuthInvalidScope, "the openid scope is required"})
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(supportedScopes, scope) {
+			return "", fmt.Errorf("%s: %w", op, &OAuthError{OAuthInvalidScope, "unsupported scope " + scope})
+		}
+	}
+	if req.CodeChallenge == "" || req.CodeChallengeMethod != codeChallengeMethodS256 {
+		return "", fmt.Errorf("%s: %w", op, &OAuthError{OAuthInvalidRequest, "code_challenge with the S256 method is required"})
+	}
+
+	code, hash, err := newRefreshToken()
+	if err != nil {
+		log.Error("failed to generate authorization code", sl.Err(err))
+
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	err = a.oauthCodes.CreateCode(ctx, hash, domain.AuthorizationCode{
+		AppId:         app.ID,
+		UserId:        userId,
+		Login:         login,
+		RedirectURI:   req.RedirectURI,
+		Scope:         strings.Join(scopes, " "),
+		Nonce:         req.Nonce,
+		CodeChallenge: req.CodeChallenge,
+		AuthTime:      jwt.IssuedAt(claims),
+	}, a.oauth.CodeTTL)
+	if err != nil {
+		log.Error("failed to save authorization code", sl.Err(err))
+
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("client authorized", slog.String("user", userId.String()))
+
+	return code, nil
+}
+
+// Token exchanges the authorization code for an access token and an ID token.
+// The client authenticates with its secret and proves it started the flow with the PKCE verifier.
+// A code is accepted once.
+func (a *Auth) Token(ctx context.Context, req domain.TokenRequest) (*domain.OAuthTokens, error) {
+	const op = "Auth.Token"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("client", req.ClientId),
+	)
+
+	if a.oauth.Issuer == "" {
+		return nil, fmt.Errorf("%s: %w", op, ErrOAuthDisabled)
+	}
+	app, err := a.oauthClient(ctx, req.ClientId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !a.appProvider.VerifySecret(app, req.ClientSecret) {
+		log.Info("invalid client secret")
+
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidClient)
+	}
+	if req.GrantType != "authorization_code" {
+		return nil, fmt.Errorf("%s: %w", op, &OAuthError{OAuthUnsupportedGrantType, "only the authorization_code grant is supported"})
+	}
+	if req.Code == "" || req.CodeVerifier == "" {
+		return nil, fmt.Errorf("%s: %w", op, &OAuthError{OAuthInvalidRequest, "code and code_verifier are required"})
+	}
+
+	hash := sha256.Sum256([]byte(req.Code))
+	code, err := a.oauthCodes.TakeCode(ctx, hash[:])
+	if err != nil {
+		if errors.Is(err, repositories.ErrAuthorizationCodeNotFound) {
+			return nil, fmt.Errorf("%s: %w", op, &OAuthError{OAuthInvalidGrant, "code is invalid, expired or used"})
+		}
+		log.Error("failed to take authorization code", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if code.AppId != app.ID || code.RedirectURI != req.RedirectURI {
+		log.Info("code was issued to another client or redirect uri")
+
+		return nil, fmt.Errorf("%s: %w", op, &OAuthError{OAuthInvalidGrant, "code was issued to another client or redirect_uri"})
+	}
+	if !verifyCodeChallenge(req.CodeVerifier, code.CodeChallenge) {
+		log.Info("code verifier doesn't match")
+
+		return nil, fmt.Errorf("%s: %w", op, &OAuthError{OAuthInvalidGrant, "code_verifier doesn't match the code_challenge"})
+	}
+
+	key, err := a.signer.SigningKey(ctx)
+	if err != nil {
+		log.Error("failed to get signing key", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	accessToken, err := jwt.NewOAuthToken(*code, app, a.oauth.Issuer, a.tokenTTL, key)
+	if err != nil {
+		log.Error("failed to issue access token", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	idToken, err := jwt.NewIDToken(*code, app, a.oauth.Issuer, a.tokenTTL, key)
+	if err != nil {
+		log.Error("failed to issue id token", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("authorization code exchanged", slog.String("user", code.UserId.String()))
+
+	return &domain.OAuthTokens{
+		AccessToken: accessToken,
+		IDToken:     idToken,
+		ExpiresIn:   a.tokenTTL,
+		Scope:       code.Scope,
+	}, nil
+}
+
+// UserInfo returns claims about the user of an access token issued with the openid scope.
+func (a *Auth) UserInfo(ctx context.Context, accessToken string) (*domain.UserInfo, error) {
+	const op = "Auth.UserInfo"
+
+	if a.oauth.Issuer == "" {
+		return nil, fmt.Errorf("%s: %w", op, ErrOAuthDisabled)
+	}
+	claims, err := a.verifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	scope, _ := claims["scope"].(string)
+	if !jwt.HasScope(scope, domain.ScopeOpenID) {
+		return nil, fmt.Errorf("%s: %w", op, ErrInsufficientScope)
+	}
+
+	info := &domain.UserInfo{Subject: fmt.Sprint(claims["sub"])}
+	if jwt.HasScope(scope, domain.ScopeProfile) {
+		info.PreferredUsername, _ = claims["login"].(string)
+	}
+	return info, nil
+}
+
+// OpenIDConfiguration returns the discovery metadata, endpoints are served under the issuer.
+func (a *Auth) OpenIDConfiguration(ctx context.Context) (*domain.OpenIDConfiguration, error) {
+	const op = "Auth.OpenIDConfiguration"
+
+	if a.oauth.Issuer == "" {
+		return nil, fmt.Errorf("%s: %w", op, ErrOAuthDisabled)
+	}
+	key, err := a.signer.SigningKey(ctx)
+	if err != nil {
+		a.log.With(slog.String("op", op)).Error("failed to get signing key", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	issuer := strings.TrimSuffix(a.oauth.Issuer, "/")
+	return &domain.OpenIDConfiguration{
+		Issuer:                a.oauth.Issuer,
+		AuthorizationEndpoint: issuer + "/oauth/authorize",
+		TokenEndpoint:         issuer + "/oauth/token",
+		UserInfoEndpoint:      issuer + "/oauth/userinfo",
+		JWKSURI:               issuer + "/.well-known/jwks.json",
+		SigningAlgorithm:      key.Algorithm,
+		Scopes:                supportedScopes,
+	}, nil
+}
+
+// oauthClient returns the enabled app of the client_id.
+func (a *Auth) oauthClient(ctx context.Context, clientId string) (domain.App, error) {
+	appId, err := strconv.ParseInt(clientId, 10, 64)
+	if err != nil {
+		return domain.App{}, ErrInvalidClient
+	}
+	app, err := a.appProvider.App(ctx, appId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAppNotFound) {
+			return domain.App{}, ErrInvalidClient
+		}
+		return domain.App{}, err
+	}
+	if app.Disabled {
+		return domain.App{}, ErrInvalidClient
+	}
+	return app, nil
+}
+
+// verifyAccessToken checks the signature, expiry and revocation of the token
+// the way JWTMiddleware does and returns its claims.
+func (a *Auth) verifyAccessToken(ctx context.Context, accessToken string) (gojwt.MapClaims, error) {
+	err, token := jwt.ProcessJWT(ctx, accessToken, a.appProvider, a.signer)
+	if err != nil {
+		return nil, ErrInvalidAccessToken
+	}
+	claims, ok := token.Claims.(gojwt.MapClaims)
+	if !ok {
+		return nil, ErrInvalidAccessToken
+	}
+	uid, _ := uuid.Parse(fmt.Sprint(claims["uid"]))
+	jti, _ := uuid.Parse(fmt.Sprint(claims["jti"]))
+	sid, _ := uuid.Parse(fmt.Sprint(claims["sid"]))
+	revoked, err := a.revoker.IsRevoked(ctx, uid, []uuid.UUID{jti, sid}, jwt.IssuedAt(claims))
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, ErrInvalidAccessToken
+	}
+	return claims, nil
+}
+
+// verifyCodeChallenge checks the verifier against the S256 challenge, see RFC 7636.
+func verifyCodeChallenge(verifier string, challenge string) bool {
+	if len(verifier) < minCodeVerifierLength || len(verifier) > maxCodeVerifierLength {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"strings"
+	"testing"
+)
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	verifier := strings.Repeat("v", minCodeVerifierLength)
+	long := strings.Repeat("l", maxCodeVerifierLength)
+	tests := []struct {
+		name      string
+		verifier  string
+		challenge string
+		want      bool
+	}{
+		{name: "matching", verifier: verifier, challenge: codeChallenge(verifier), want: true},
+		{name: "longest verifier", verifier: long, challenge: codeChallenge(long), want: true},
+		{name: "other verifier", verifier: verifier + "x", challenge: codeChallenge(verifier)},
+		{name: "plain method", verifier: verifier, challenge: verifier},
+		{name: "padded challenge", verifier: verifier, challenge: codeChallenge(verifier) + "="},
+		{name: "short verifier", verifier: "short", challenge: codeChallenge("short")},
+		{name: "verifier too long", verifier: long + "l", challenge: codeChallenge(long + "l")},
+		{name: "empty", verifier: "", challenge: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verifyCodeChallenge(tt.verifier, tt.challenge); got != tt.want {
+				t.Errorf("verifyCodeChallenge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenAuthenticatesClient(t *testing.T) {
+	secret := "client secret"
+	hash := sha256.Sum256([]byte(secret))
+	a := &Auth{
+		log: discardLogger(),
+		appProvider: appsById{
+			1: {ID: 1, Name: "client", SecretHash: hash[:]},
+			2: {ID: 2, Name: "without secret"},
+			3: {ID: 3, Name: "disabled", SecretHash: hash[:], Disabled: true},
+		},
+		oauth: OAuthOptions{Issuer: "https://auth.example.com"},
+	}
+	tests := []struct {
+		name     string
+		clientId string
+		secret   string
+		wantErr  error
+	}{
+		{name: "wrong secret", clientId: "1", secret: "guess", wantErr: ErrInvalidClient},
+		{name: "empty secret", clientId: "1", secret: "", wantErr: ErrInvalidClient},
+		{name: "hash instead of secret", clientId: "1", secret: string(hash[:]), wantErr: ErrInvalidClient},
+		{name: "app without secret", clientId: "2", secret: "", wantErr: ErrInvalidClient},
+		{name: "disabled app", clientId: "3", secret: secret, wantErr: ErrInvalidClient},
+		{name: "unknown app", clientId: "4", secret: secret, wantErr: ErrInvalidClient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := a.Token(context.Background(), domain.TokenRequest{
+				GrantType:    "authorization_code",
+				Code:         "code",
+				CodeVerifier: strings.Repeat("v", minCodeVerifierLength),
+				ClientId:     tt.clientId,
+				ClientSecret: tt.secret,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Token() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// With the right secret the client gets past authentication to the grant checks.
+	_, err := a.Token(context.Background(), domain.TokenRequest{GrantType: "password", ClientId: "1", ClientSecret: secret})
+	var oauthErr *OAuthError
+	if !errors.As(err, &oauthErr) || oauthErr.Code != OAuthUnsupportedGrantType {
+		t.Errorf("Token() error = %v, want %s", err, OAuthUnsupportedGrantType)
+	}
+}
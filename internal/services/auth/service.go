@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/s0vunia/password-manager/internal/domain"
 	"github.com/s0vunia/password-manager/internal/lib/jwt"
+	"github.com/s0vunia/password-manager/internal/lib/kdf"
 	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
 	"github.com/s0vunia/password-manager/internal/repositories"
 	"golang.org/x/crypto/bcrypt"
@@ -20,12 +21,60 @@ type IOAuth interface {
 		login string,
 		password string,
 		appID int,
-	) (token string, err error)
+		client domain.ClientInfo,
+	) (*domain.LoginResult, error)
+	VerifyLogin(
+		ctx context.Context,
+		challengeToken string,
+		code string,
+		assertion *domain.WebAuthnAssertion,
+		client domain.ClientInfo,
+	) (*domain.LoginResult, error)
+	Refresh(ctx context.Context, refreshToken string, client domain.ClientInfo) (*domain.LoginResult, error)
+	ListSessions(ctx context.Context, userId uuid.UUID) ([]domain.Session, error)
+	RevokeSession(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID) error
+	Logout(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID, tokenId uuid.UUID) error
+	RevokeAllSessions(ctx context.Context, userId uuid.UUID) (int, error)
+	JWKS(ctx context.Context) (jwt.JWKS, error)
+	Authorize(ctx context.Context, accessToken string, req domain.AuthorizationRequest) (string, error)
+	Token(ctx context.Context, req domain.TokenRequest) (*domain.OAuthTokens, error)
+	UserInfo(ctx context.Context, accessToken string) (*domain.UserInfo, error)
+	OpenIDConfiguration(ctx context.Context) (*domain.OpenIDConfiguration, error)
+	EnrollTOTP(ctx context.Context, userId uuid.UUID) (*domain.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, userId uuid.UUID, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, userId uuid.UUID, code string) error
+	BeginWebAuthnRegistration(ctx context.Context, userId uuid.UUID) ([]byte, error)
+	FinishWebAuthnRegistration(
+		ctx context.Context,
+		userId uuid.UUID,
+		name string,
+		clientDataJSON []byte,
+		attestationObject []byte,
+	) (*domain.WebAuthnCredential, []string, error)
+	ListWebAuthnCredentials(ctx context.Context, userId uuid.UUID) ([]domain.WebAuthnCredential, error)
+	DeleteWebAuthnCredential(ctx context.Context, userId uuid.UUID, id uuid.UUID) error
 	RegisterNewUser(
 		ctx context.Context,
 		login string,
 		password string,
 	) (userID uuid.UUID, err error)
+	KDFParams(
+		ctx context.Context,
+		login string,
+	) (domain.KDFParams, error)
+}
+
+// ZeroKnowledgeOptions configure opt-in zero-knowledge mode.
+// When enabled, new users register with an auth hash derived on the client
+// (see kdf.AuthHash) instead of the master password, and encrypt vault items
+// before sending them, so the server only stores opaque blobs.
+type ZeroKnowledgeOptions struct {
+	Enabled bool
+	// SaltSecret makes per-user salts unpredictable, it must stay stable across restarts.
+	SaltSecret  []byte
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
 }
 
 type Auth struct {
@@ -33,15 +82,28 @@ type Auth struct {
 	usrSaver    UserSaver
 	usrProvider UserProvider
 	appProvider AppProvider
+	keyCreator  KeyCreator
+	twoFactor   TwoFactorStore
+	keyProvider KeyProvider
+	sessions    SessionStore
+	revoker     TokenRevoker
+	signer      TokenSigner
+	oauthCodes  OAuthStore
+	zk          ZeroKnowledgeOptions
+	tfa         TwoFactorOptions
+	oauth       OAuthOptions
 	tokenTTL    time.Duration
+	refreshTTL  time.Duration
 }
 
 var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidAuthHash    = errors.New("password must be a client-derived auth hash")
+	ErrAppDisabled        = errors.New("app is disabled")
 )
 
 type UserSaver interface {
-	Create(ctx context.Context, login string, passHash []byte) (uuid.UUID, error)
+	Create(ctx context.Context, login string, passHash []byte, kdf *domain.KDFParams) (uuid.UUID, error)
 }
 
 type UserProvider interface {
@@ -50,6 +112,12 @@ type UserProvider interface {
 
 type AppProvider interface {
 	App(ctx context.Context, appID int64) (domain.App, error)
+	RedirectURIs(ctx context.Context, appID int64) ([]string, error)
+	VerifySecret(app domain.App, secret string) bool
+}
+
+type KeyCreator interface {
+	CreateUserKey(ctx context.Context, userId uuid.UUID) error
 }
 
 func New(
@@ -57,18 +125,42 @@ func New(
 	userSaver UserSaver,
 	userProvider UserProvider,
 	appProvider AppProvider,
+	keyCreator KeyCreator,
+	twoFactor TwoFactorStore,
+	keyProvider KeyProvider,
+	sessions SessionStore,
+	revoker TokenRevoker,
+	signer TokenSigner,
+	oauthCodes OAuthStore,
+	zk ZeroKnowledgeOptions,
+	tfa TwoFactorOptions,
+	oauth OAuthOptions,
 	tokenTTL time.Duration,
+	refreshTTL time.Duration,
 ) *Auth {
 	return &Auth{
 		usrSaver:    userSaver,
 		usrProvider: userProvider,
 		log:         log,
 		appProvider: appProvider,
+		keyCreator:  keyCreator,
+		twoFactor:   twoFactor,
+		keyProvider: keyProvider,
+		sessions:    sessions,
+		revoker:     revoker,
+		signer:      signer,
+		oauthCodes:  oauthCodes,
+		zk:          zk,
+		tfa:         tfa,
+		oauth:       oauth,
 		tokenTTL:    tokenTTL,
+		refreshTTL:  refreshTTL,
 	}
 }
 
-// Login checks if user with given credentials exists in the system and returns access token.
+// Login checks if user with given credentials exists in the system, opens a session
+// and returns its access and refresh tokens.
+// Users with two-factor authentication get a challenge token instead, see VerifyLogin.
 //
 // If user exists, but password is incorrect, returns error.
 // If user doesn't exist, returns error.
@@ -77,7 +169,8 @@ func (a *Auth) Login(
 	login string,
 	password string,
 	appID int,
-) (string, error) {
+	client domain.ClientInfo,
+) (*domain.LoginResult, error) {
 	const op = "Auth.Login"
 
 	log := a.log.With(
@@ -92,37 +185,61 @@ func (a *Auth) Login(
 		if errors.Is(err, repositories.ErrUserNotFound) {
 			a.log.Warn("user not found", sl.Err(err))
 
-			return "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 		}
 
 		a.log.Error("failed to get user", sl.Err(err))
 
-		return "", fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.KDF != nil && !kdf.IsAuthHash(password) {
+		a.log.Info("zero-knowledge user sent a non auth hash password")
+
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 	}
 
 	if err := bcrypt.CompareHashAndPassword(user.PassHash, []byte(password)); err != nil {
 		a.log.Info("invalid credentials", sl.Err(err))
 
-		return "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 	}
 
 	app, err := a.appProvider.App(ctx, int64(appID))
 	if err != nil {
 		a.log.Info("failed to get app", sl.Err(err))
 
-		return "", fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	if app.Disabled {
+		log.Info("app is disabled", slog.Int("app", app.ID))
 
-	log.Info("user logged in successfully")
+		return nil, fmt.Errorf("%s: %w", op, ErrAppDisabled)
+	}
+
+	if user.TOTPEnabled || user.WebAuthnEnabled {
+		result, err := a.challenge(ctx, user, app)
+		if err != nil {
+			a.log.Error("failed to create login challenge", sl.Err(err))
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		log.Info("second factor required")
 
-	token, err := jwt.NewToken(*user, app, a.tokenTTL)
+		return result, nil
+	}
+
+	result, err := a.openSession(ctx, *user, app, client)
 	if err != nil {
-		a.log.Error("failed to generate token", sl.Err(err))
+		a.log.Error("failed to open session", sl.Err(err))
 
-		return "", fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return token, nil
+	log.Info("user logged in successfully")
+
+	return result, nil
 }
 
 // RegisterNewUser registers new user in the system and returns user ID.
@@ -137,6 +254,17 @@ func (a *Auth) RegisterNewUser(ctx context.Context, login string, pass string) (
 
 	log.Info("registering user")
 
+	var params *domain.KDFParams
+	if a.zk.Enabled {
+		if !kdf.IsAuthHash(pass) {
+			log.Info("password is not an auth hash")
+
+			return uuid.UUID{}, fmt.Errorf("%s: %w", op, ErrInvalidAuthHash)
+		}
+		p := a.defaultKDFParams(login)
+		params = &p
+	}
+
 	passHash, err := bcrypt.GenerateFromPassword([]byte(pass), bcrypt.DefaultCost)
 	if err != nil {
 		log.Error("failed to generate password hash", sl.Err(err))
@@ -144,12 +272,57 @@ func (a *Auth) RegisterNewUser(ctx context.Context, login string, pass string) (
 		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	id, err := a.usrSaver.Create(ctx, login, passHash)
+	id, err := a.usrSaver.Create(ctx, login, passHash, params)
 	if err != nil {
 		log.Error("failed to save user", sl.Err(err))
 
 		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	// The key is generated lazily on first use if this fails,
+	// so the registration itself doesn't have to be rolled back.
+	if err := a.keyCreator.CreateUserKey(ctx, id); err != nil {
+		log.Warn("failed to create user key", sl.Err(err))
+	}
+
 	return id, nil
 }
+
+// KDFParams returns parameters the client needs to derive master key and auth hash for login.
+// Logins without parameters, unknown ones and users registered without zero-knowledge mode,
+// get the parameters they would be registered with, so the response doesn't reveal whether
+// the user exists or how it registered. Users registered without zero-knowledge mode ignore
+// them and keep logging in with the master password.
+func (a *Auth) KDFParams(ctx context.Context, login string) (domain.KDFParams, error) {
+	const op = "Auth.KDFParams"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("login", login),
+	)
+
+	user, err := a.usrProvider.Get(ctx, login)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrUserNotFound) {
+			log.Error("failed to get user", sl.Err(err))
+
+			return domain.KDFParams{}, fmt.Errorf("%s: %w", op, err)
+		}
+		return a.defaultKDFParams(login), nil
+	}
+
+	if user.KDF == nil {
+		return a.defaultKDFParams(login), nil
+	}
+	return *user.KDF, nil
+}
+
+func (a *Auth) defaultKDFParams(login string) domain.KDFParams {
+	return domain.KDFParams{
+		Algorithm:   domain.KDFAlgorithmArgon2id,
+		Salt:        kdf.Salt(a.zk.SaltSecret, login),
+		Memory:      a.zk.Memory,
+		Iterations:  a.zk.Iterations,
+		Parallelism: a.zk.Parallelism,
+	}
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+type usersByLogin map[string]*domain.User
+
+func (u usersByLogin) Get(ctx context.Context, login string) (*domain.User, error) {
+	user, ok := u[login]
+	if !ok {
+		return nil, repositories.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestKDFParams(t *testing.T) {
+	stored := domain.KDFParams{
+		Algorithm:   domain.KDFAlgorithmArgon2id,
+		Salt:        []byte("stored salt"),
+		Memory:      1024,
+		Iterations:  1,
+		Parallelism: 1,
+	}
+	users := usersByLogin{
+		"zk":     {Login: "zk", KDF: &stored},
+		"legacy": {Login: "legacy"},
+	}
+	zk := ZeroKnowledgeOptions{SaltSecret: []byte("secret"), Memory: 65536, Iterations: 3, Parallelism: 4}
+
+	for _, enabled := range []bool{true, false} {
+		zk.Enabled = enabled
+		a := &Auth{log: discardLogger(), usrProvider: users, zk: zk}
+		tests := []struct {
+			login string
+			want  domain.KDFParams
+		}{
+			{login: "zk", want: stored},
+			{login: "legacy", want: a.defaultKDFParams("legacy")},
+			{login: "unknown", want: a.defaultKDFParams("unknown")},
+		}
+		for _, tt := range tests {
+			got, err := a.KDFParams(context.Background(), tt.login)
+			if err != nil {
+				t.Fatalf("enabled=%v KDFParams(%q) error = %v", enabled, tt.login, err)
+			}
+			if got.Algorithm != tt.want.Algorithm || !bytes.Equal(got.Salt, tt.want.Salt) ||
+				got.Memory != tt.want.Memory || got.Iterations != tt.want.Iterations || got.Parallelism != tt.want.Parallelism {
+				t.Errorf("enabled=%v KDFParams(%q) = %+v, want %+v", enabled, tt.login, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestKDFParamsSaltIsPerLogin(t *testing.T) {
+	a := &Auth{log: discardLogger(), usrProvider: usersByLogin{}, zk: ZeroKnowledgeOptions{SaltSecret: []byte("secret")}}
+	first, _ := a.KDFParams(context.Background(), "alice")
+	again, _ := a.KDFParams(context.Background(), "alice")
+	other, _ := a.KDFParams(context.Background(), "bob")
+	if !bytes.Equal(first.Salt, again.Salt) {
+		t.Error("salt of an unknown login changes between requests")
+	}
+	if bytes.Equal(first.Salt, other.Salt) {
+		t.Error("different logins get the same salt")
+	}
+}
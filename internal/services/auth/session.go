@@ -0,0 +1,251 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	refreshTokenSize    = 32
+	maxDeviceNameLength = 100
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("refresh token is invalid or expired")
+)
+
+type SessionStore interface {
+	Create(ctx context.Context, session domain.Session, tokenHash []byte, ttl time.Duration) (uuid.UUID, error)
+	Rotate(ctx context.Context, tokenHash []byte, newTokenHash []byte, ip string, ttl time.Duration) (*domain.Session, error)
+	List(ctx context.Context, userId uuid.UUID) ([]domain.Session, error)
+	Revoke(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID) error
+	RevokeAll(ctx context.Context, userId uuid.UUID) (int, error)
+}
+
+type TokenRevoker interface {
+	RevokeToken(ctx context.Context, userId uuid.UUID, id uuid.UUID) error
+	RevokeAll(ctx context.Context, userId uuid.UUID) error
+	IsRevoked(ctx context.Context, userId uuid.UUID, ids []uuid.UUID, issuedAt time.Time) (bool, error)
+}
+
+// Refresh exchanges the refresh token for a new access token and a new refresh token.
+// Each refresh token is accepted once, using a rotated one again revokes the whole session.
+func (a *Auth) Refresh(ctx context.Context, refreshToken string, client domain.ClientInfo) (*domain.LoginResult, error) {
+	const op = "Auth.Refresh"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	newToken, newHash, err := newRefreshToken()
+	if err != nil {
+		log.Error("failed to generate refresh token", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	hash := sha256.Sum256([]byte(refreshToken))
+	session, err := a.sessions.Rotate(ctx, hash[:], newHash, client.IP, a.refreshTTL)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRefreshTokenReused) {
+			log.Warn("refresh token reused, session revoked",
+				slog.String("ip", client.IP),
+				slog.String("user", session.UserId.String()),
+				slog.String("session", session.ID.String()),
+			)
+
+			// Access tokens of the session may be in the attacker's hands as well.
+			if err := a.revoker.RevokeToken(ctx, session.UserId, session.ID); err != nil {
+				log.Error("failed to revoke session tokens", sl.Err(err))
+
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		}
+		if errors.Is(err, repositories.ErrSessionNotFound) {
+			log.Info("session not found")
+
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		}
+		log.Error("failed to rotate refresh token", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(
+		slog.String("user", session.UserId.String()),
+		slog.String("session", session.ID.String()),
+	)
+
+	app, err := a.appProvider.App(ctx, int64(session.AppId))
+	if err != nil {
+		log.Info("failed to get app", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if app.Disabled {
+		log.Info("app is disabled", slog.Int("app", app.ID))
+
+		return nil, fmt.Errorf("%s: %w", op, ErrAppDisabled)
+	}
+	token, err := a.newToken(ctx, domain.User{ID: session.UserId, Login: session.Login}, app, session.ID)
+	if err != nil {
+		log.Error("failed to generate token", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("session refreshed")
+
+	return &domain.LoginResult{Token: token, RefreshToken: newToken, SessionId: session.ID}, nil
+}
+
+func (a *Auth) ListSessions(ctx context.Context, userId uuid.UUID) ([]domain.Session, error) {
+	const op = "Auth.ListSessions"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	sessions, err := a.sessions.List(ctx, userId)
+	if err != nil {
+		log.Error("failed to list sessions", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return sessions, nil
+}
+
+// RevokeSession ends the session, its refresh token and access tokens are rejected from now on.
+func (a *Auth) RevokeSession(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID) error {
+	const op = "Auth.RevokeSession"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("session", sessionId.String()),
+	)
+
+	log.Info("attempting to revoke session")
+
+	if err := a.sessions.Revoke(ctx, userId, sessionId); err != nil {
+		log.Error("failed to revoke session", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := a.revoker.RevokeToken(ctx, userId, sessionId); err != nil {
+		log.Error("failed to revoke session tokens", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Logout ends the session of the access token and revokes the token itself,
+// which also covers tokens issued before sessions existed.
+func (a *Auth) Logout(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID, tokenId uuid.UUID) error {
+	const op = "Auth.Logout"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to logout")
+
+	if tokenId != uuid.Nil {
+		if err := a.revoker.RevokeToken(ctx, userId, tokenId); err != nil {
+			log.Error("failed to revoke token", sl.Err(err))
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if sessionId == uuid.Nil {
+		return nil
+	}
+	if err := a.RevokeSession(ctx, userId, sessionId); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// RevokeAllSessions ends every session of the user and revokes all access tokens issued so far.
+// It returns the number of sessions ended.
+func (a *Auth) RevokeAllSessions(ctx context.Context, userId uuid.UUID) (int, error) {
+	const op = "Auth.RevokeAllSessions"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to revoke all sessions")
+
+	revoked, err := a.sessions.RevokeAll(ctx, userId)
+	if err != nil {
+		log.Error("failed to revoke sessions", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := a.revoker.RevokeAll(ctx, userId); err != nil {
+		log.Error("failed to revoke tokens", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("all sessions revoked", slog.Int("sessions", revoked))
+
+	return revoked, nil
+}
+
+// openSession starts a session of the user and issues its tokens, only the hash of the refresh token is stored.
+func (a *Auth) openSession(ctx context.Context, user domain.User, app domain.App, client domain.ClientInfo) (*domain.LoginResult, error) {
+	refreshToken, hash, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	sessionId, err := a.sessions.Create(ctx, domain.Session{
+		UserId:     user.ID,
+		AppId:      app.ID,
+		DeviceName: deviceName(client.DeviceName),
+		IP:         client.IP,
+	}, hash, a.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+	token, err := a.newToken(ctx, user, app, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.LoginResult{Token: token, RefreshToken: refreshToken, SessionId: sessionId}, nil
+}
+
+func newRefreshToken() (string, []byte, error) {
+	raw := make([]byte, refreshTokenSize)
+	if _, err := rand.Read(raw); err != nil {
+		return "", nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	hash := sha256.Sum256([]byte(token))
+	return token, hash[:], nil
+}
+
+// deviceName trims the client supplied name to a sane length.
+func deviceName(name string) string {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) <= maxDeviceNameLength {
+		return name
+	}
+	return string([]rune(name)[:maxDeviceNameLength])
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/jwt"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"testing"
+	"time"
+)
+
+// rotatingSessions keeps one session and the hashes of its refresh tokens, like the session repository.
+type rotatingSessions struct {
+	session domain.Session
+	current []byte
+	used    [][]byte
+	revoked bool
+}
+
+func (s *rotatingSessions) Create(ctx context.Context, session domain.Session, tokenHash []byte, ttl time.Duration) (uuid.UUID, error) {
+	return uuid.Nil, errors.New("not implemented")
+}
+
+func (s *rotatingSessions) Rotate(ctx context.Context, tokenHash []byte, newTokenHash []byte, ip string, ttl time.Duration) (*domain.Session, error) {
+	for _, used := range s.used {
+		if string(used) == string(tokenHash) {
+			s.revoked = true
+			return &domain.Session{ID: s.session.ID, UserId: s.session.UserId}, repositories.ErrRefreshTokenReused
+		}
+	}
+	if s.revoked || string(tokenHash) != string(s.current) {
+		return nil, repositories.ErrSessionNotFound
+	}
+	s.used = append(s.used, s.current)
+	s.current = newTokenHash
+	session := s.session
+	return &session, nil
+}
+
+func (s *rotatingSessions) List(ctx context.Context, userId uuid.UUID) ([]domain.Session, error) {
+	return nil, nil
+}
+
+func (s *rotatingSessions) Revoke(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID) error {
+	return nil
+}
+
+func (s *rotatingSessions) RevokeAll(ctx context.Context, userId uuid.UUID) (int, error) {
+	return 0, nil
+}
+
+// revokedIds records ids passed to RevokeToken.
+type revokedIds map[uuid.UUID]uuid.UUID
+
+func (r revokedIds) RevokeToken(ctx context.Context, userId uuid.UUID, id uuid.UUID) error {
+	r[id] = userId
+	return nil
+}
+
+func (r revokedIds) RevokeAll(ctx context.Context, userId uuid.UUID) error {
+	return nil
+}
+
+func (r revokedIds) IsRevoked(ctx context.Context, userId uuid.UUID, ids []uuid.UUID, issuedAt time.Time) (bool, error) {
+	return false, nil
+}
+
+type appsById map[int64]domain.App
+
+func (a appsById) App(ctx context.Context, appID int64) (domain.App, error) {
+	app, ok := a[appID]
+	if !ok {
+		return domain.App{}, repositories.ErrAppNotFound
+	}
+	return app, nil
+}
+
+func (a appsById) RedirectURIs(ctx context.Context, appID int64) ([]string, error) {
+	return a[appID].RedirectURIs, nil
+}
+
+func (a appsById) VerifySecret(app domain.App, secret string) bool {
+	sum := sha256.Sum256([]byte(secret))
+	return len(app.SecretHash) > 0 && string(app.SecretHash) == string(sum[:])
+}
+
+type staticSigner struct {
+	key *jwt.SigningKey
+}
+
+func (s staticSigner) SigningKey(ctx context.Context) (*jwt.SigningKey, error) {
+	return s.key, nil
+}
+
+func (s staticSigner) VerificationKey(ctx context.Context, kid string) (*jwt.SigningKey, error) {
+	return s.key, nil
+}
+
+func (s staticSigner) JWKS(ctx context.Context) (jwt.JWKS, error) {
+	return jwt.JWKS{}, nil
+}
+
+func TestRefreshDetectsReuse(t *testing.T) {
+	key, err := jwt.GenerateKey(jwt.AlgorithmEdDSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := domain.Session{ID: uuid.New(), UserId: uuid.New(), Login: "alice", AppId: 1}
+	first := "first refresh token"
+	firstHash := sha256.Sum256([]byte(first))
+	sessions := &rotatingSessions{session: session, current: firstHash[:]}
+	revoked := revokedIds{}
+	a := &Auth{
+		log:         discardLogger(),
+		appProvider: appsById{1: {ID: 1, Name: "test"}},
+		sessions:    sessions,
+		revoker:     revoked,
+		signer:      staticSigner{key: key},
+		tokenTTL:    time.Hour,
+		refreshTTL:  time.Hour,
+	}
+	client := domain.ClientInfo{IP: "127.0.0.1"}
+
+	result, err := a.Refresh(context.Background(), first, client)
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if result.RefreshToken == "" || result.RefreshToken == first || result.Token == "" {
+		t.Fatalf("Refresh() = %+v, want new tokens", result)
+	}
+	if len(revoked) != 0 {
+		t.Fatalf("tokens revoked on a regular refresh: %v", revoked)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "rotated token used again", token: first},
+		{name: "current token after reuse", token: result.RefreshToken},
+		{name: "unknown token", token: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := a.Refresh(context.Background(), tt.token, client); !errors.Is(err, ErrInvalidRefreshToken) {
+				t.Errorf("Refresh() error = %v, want %v", err, ErrInvalidRefreshToken)
+			}
+		})
+	}
+	if !sessions.revoked {
+		t.Error("session isn't revoked after reuse")
+	}
+	if userId, ok := revoked[session.ID]; !ok || userId != session.UserId {
+		t.Errorf("access tokens of session %s aren't revoked: %v", session.ID, revoked)
+	}
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/jwt"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"log/slog"
+)
+
+// TokenSigner provides the key access tokens are signed with.
+type TokenSigner interface {
+	SigningKey(ctx context.Context) (*jwt.SigningKey, error)
+	VerificationKey(ctx context.Context, kid string) (*jwt.SigningKey, error)
+	JWKS(ctx context.Context) (jwt.JWKS, error)
+}
+
+// JWKS returns public keys other services verify access tokens with.
+func (a *Auth) JWKS(ctx context.Context) (jwt.JWKS, error) {
+	const op = "Auth.JWKS"
+
+	jwks, err := a.signer.JWKS(ctx)
+	if err != nil {
+		a.log.With(slog.String("op", op)).Error("failed to get signing keys", sl.Err(err))
+
+		return jwt.JWKS{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return jwks, nil
+}
+
+func (a *Auth) newToken(ctx context.Context, user domain.User, app domain.App, sessionId uuid.UUID) (string, error) {
+	key, err := a.signer.SigningKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	return jwt.NewToken(user, app, sessionId, a.tokenTTL, key)
+}
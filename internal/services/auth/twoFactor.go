@@ -0,0 +1,436 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"github.com/s0vunia/password-manager/internal/lib/totp"
+	"github.com/s0vunia/password-manager/internal/lib/webauthn"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/skip2/go-qrcode"
+	"golang.org/x/crypto/bcrypt"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	// totpSkew is the number of periods a code is accepted before and after the current one.
+	totpSkew = 1
+
+	recoveryCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	recoveryCodeLength   = 10
+	qrCodeSize           = 256
+)
+
+var (
+	ErrInvalidCode      = errors.New("invalid two-factor code")
+	ErrInvalidChallenge = errors.New("login challenge is invalid or expired")
+)
+
+// TwoFactorOptions configure two-factor authentication of user accounts.
+// Issuer is the name authenticator apps show for the account,
+// a login challenge can be answered MaxAttempts times within ChallengeTTL.
+// Security keys can be registered only if WebAuthn is configured.
+type TwoFactorOptions struct {
+	Issuer        string
+	ChallengeTTL  time.Duration
+	MaxAttempts   int
+	RecoveryCodes int
+	WebAuthn      webauthn.Config
+}
+
+type TwoFactorStore interface {
+	GetTOTP(ctx context.Context, userId uuid.UUID) (*domain.UserTOTP, error)
+	SaveTOTPSecret(ctx context.Context, userId uuid.UUID, secret string) error
+	EnableTOTP(ctx context.Context, userId uuid.UUID, counter uint64, codeHashes [][]byte) error
+	DisableTOTP(ctx context.Context, userId uuid.UUID) error
+	UseTOTPCounter(ctx context.Context, userId uuid.UUID, counter uint64) (bool, error)
+	GetRecoveryCodes(ctx context.Context, userId uuid.UUID) ([]domain.RecoveryCode, error)
+	UseRecoveryCode(ctx context.Context, codeId uuid.UUID) (bool, error)
+	CreateLoginChallenge(ctx context.Context, tokenHash []byte, userId uuid.UUID, appId int, webAuthnChallenge []byte, ttl time.Duration) error
+	AttemptLoginChallenge(ctx context.Context, tokenHash []byte) (*domain.LoginChallenge, error)
+	DeleteLoginChallenge(ctx context.Context, challengeId uuid.UUID) error
+	SaveWebAuthnRegistration(ctx context.Context, userId uuid.UUID, challenge []byte, ttl time.Duration) error
+	TakeWebAuthnRegistration(ctx context.Context, userId uuid.UUID) ([]byte, error)
+	CreateWebAuthnCredential(ctx context.Context, credential domain.WebAuthnCredential, codeHashes [][]byte) (uuid.UUID, error)
+	GetWebAuthnCredentials(ctx context.Context, userId uuid.UUID) ([]domain.WebAuthnCredential, error)
+	GetWebAuthnCredential(ctx context.Context, userId uuid.UUID, credentialId []byte) (*domain.WebAuthnCredential, error)
+	UpdateWebAuthnSignCount(ctx context.Context, id uuid.UUID, previous, count uint32) (bool, error)
+	DeleteWebAuthnCredential(ctx context.Context, userId uuid.UUID, id uuid.UUID) error
+}
+
+// KeyProvider returns cipher keyed with the user's data encryption key.
+type KeyProvider interface {
+	UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error)
+}
+
+// EnrollTOTP generates a new secret for the user. It's used on login only after ConfirmTOTP,
+// enrolling again before that replaces the secret.
+func (a *Auth) EnrollTOTP(ctx context.Context, userId uuid.UUID) (*domain.TOTPEnrollment, error) {
+	const op = "Auth.EnrollTOTP"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to enroll totp")
+
+	state, err := a.twoFactor.GetTOTP(ctx, userId)
+	if err != nil {
+		log.Error("failed to get totp state", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if state.Enabled {
+		return nil, fmt.Errorf("%s: %w", op, repositories.ErrTOTPEnabled)
+	}
+
+	key, err := totp.Generate(a.tfa.Issuer, state.Login)
+	if err != nil {
+		log.Error("failed to generate totp secret", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	cipher, err := a.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	sealed, err := cipher.EncryptString(key.URI(), userId[:])
+	if err != nil {
+		log.Error("failed to encrypt totp secret", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := a.twoFactor.SaveTOTPSecret(ctx, userId, sealed); err != nil {
+		log.Error("failed to save totp secret", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	qr, err := qrcode.Encode(key.URI(), qrcode.Medium, qrCodeSize)
+	if err != nil {
+		log.Error("failed to encode qr code", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &domain.TOTPEnrollment{Secret: key.EncodedSecret(), URI: key.URI(), QRCode: qr}, nil
+}
+
+// ConfirmTOTP enables the enrolled secret once the user proves it was added to
+// an authenticator app, and returns recovery codes. They're shown only once.
+func (a *Auth) ConfirmTOTP(ctx context.Context, userId uuid.UUID, code string) ([]string, error) {
+	const op = "Auth.ConfirmTOTP"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to confirm totp")
+
+	state, err := a.twoFactor.GetTOTP(ctx, userId)
+	if err != nil {
+		log.Error("failed to get totp state", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if state.Enabled {
+		return nil, fmt.Errorf("%s: %w", op, repositories.ErrTOTPEnabled)
+	}
+	if state.Secret == "" {
+		return nil, fmt.Errorf("%s: %w", op, repositories.ErrTOTPNotEnrolled)
+	}
+
+	key, err := a.openTOTP(ctx, state)
+	if err != nil {
+		log.Error("failed to open totp secret", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	counter, ok := key.Validate(strings.TrimSpace(code), time.Now(), totpSkew)
+	if !ok {
+		log.Info("invalid totp code")
+
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCode)
+	}
+
+	codes, hashes, err := newRecoveryCodes(a.tfa.RecoveryCodes)
+	if err != nil {
+		log.Error("failed to generate recovery codes", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := a.twoFactor.EnableTOTP(ctx, userId, counter, hashes); err != nil {
+		log.Error("failed to enable totp", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("totp enabled")
+
+	return codes, nil
+}
+
+// DisableTOTP turns two-factor authentication off, code is a current TOTP code or a recovery code.
+func (a *Auth) DisableTOTP(ctx context.Context, userId uuid.UUID, code string) error {
+	const op = "Auth.DisableTOTP"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to disable totp")
+
+	state, err := a.twoFactor.GetTOTP(ctx, userId)
+	if err != nil {
+		log.Error("failed to get totp state", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !state.Enabled {
+		return fmt.Errorf("%s: %w", op, repositories.ErrTOTPNotEnrolled)
+	}
+	ok, err := a.verifySecondFactor(ctx, state, code)
+	if err != nil {
+		log.Error("failed to verify second factor", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !ok {
+		log.Info("invalid second factor")
+
+		return fmt.Errorf("%s: %w", op, ErrInvalidCode)
+	}
+
+	if err := a.twoFactor.DisableTOTP(ctx, userId); err != nil {
+		log.Error("failed to disable totp", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("totp disabled")
+
+	return nil
+}
+
+// VerifyLogin is the second step of login: it exchanges the challenge token returned by Login
+// and a TOTP code, a recovery code or a security key assertion for the access token.
+func (a *Auth) VerifyLogin(
+	ctx context.Context,
+	challengeToken string,
+	code string,
+	assertion *domain.WebAuthnAssertion,
+	client domain.ClientInfo,
+) (*domain.LoginResult, error) {
+	const op = "Auth.VerifyLogin"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	hash := sha256.Sum256([]byte(challengeToken))
+	challenge, err := a.twoFactor.AttemptLoginChallenge(ctx, hash[:])
+	if err != nil {
+		if errors.Is(err, repositories.ErrChallengeNotFound) {
+			log.Info("login challenge not found")
+
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidChallenge)
+		}
+		log.Error("failed to get login challenge", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("user", challenge.UserId.String()))
+
+	log.Info("attempting to verify second factor")
+
+	if challenge.Attempts > a.tfa.MaxAttempts {
+		log.Warn("too many second factor attempts")
+		a.dropChallenge(ctx, log, challenge.ID)
+
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidChallenge)
+	}
+
+	state, err := a.twoFactor.GetTOTP(ctx, challenge.UserId)
+	if err != nil {
+		log.Error("failed to get totp state", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	var ok bool
+	if assertion != nil {
+		ok, err = a.verifyAssertion(ctx, log, challenge, assertion)
+	} else {
+		ok, err = a.verifySecondFactor(ctx, state, code)
+	}
+	if err != nil {
+		log.Error("failed to verify second factor", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !ok {
+		log.Info("invalid second factor")
+
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCode)
+	}
+	a.dropChallenge(ctx, log, challenge.ID)
+
+	app, err := a.appProvider.App(ctx, int64(challenge.AppId))
+	if err != nil {
+		log.Info("failed to get app", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if app.Disabled {
+		log.Info("app is disabled", slog.Int("app", app.ID))
+
+		return nil, fmt.Errorf("%s: %w", op, ErrAppDisabled)
+	}
+
+	result, err := a.openSession(ctx, domain.User{ID: state.UserId, Login: state.Login}, app, client)
+	if err != nil {
+		log.Error("failed to open session", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("user logged in successfully")
+
+	return result, nil
+}
+
+// challenge starts a two-step login of the user, only the hash of the returned token is stored.
+// Users with security keys also get WebAuthn options with a challenge bound to the token.
+func (a *Auth) challenge(ctx context.Context, user *domain.User, app domain.App) (*domain.LoginResult, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	result := &domain.LoginResult{
+		ChallengeToken: base64.RawURLEncoding.EncodeToString(raw),
+		ChallengeTTL:   a.tfa.ChallengeTTL,
+	}
+	if user.TOTPEnabled {
+		result.SecondFactors = append(result.SecondFactors, domain.SecondFactorTOTP)
+	}
+
+	var webAuthnChallenge []byte
+	if user.WebAuthnEnabled && a.tfa.WebAuthn.Enabled() {
+		credentials, err := a.twoFactor.GetWebAuthnCredentials(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		allow := make([][]byte, 0, len(credentials))
+		for _, credential := range credentials {
+			allow = append(allow, credential.CredentialID)
+		}
+		webAuthnChallenge, err = webauthn.NewChallenge()
+		if err != nil {
+			return nil, err
+		}
+		result.WebAuthnOptions, err = a.tfa.WebAuthn.RequestOptions(webAuthnChallenge, allow)
+		if err != nil {
+			return nil, err
+		}
+		result.SecondFactors = append(result.SecondFactors, domain.SecondFactorWebAuthn)
+	}
+
+	hash := sha256.Sum256([]byte(result.ChallengeToken))
+	if err := a.twoFactor.CreateLoginChallenge(ctx, hash[:], user.ID, app.ID, webAuthnChallenge, a.tfa.ChallengeTTL); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// dropChallenge deletes an answered or exhausted challenge, an expired one is cleaned up later anyway.
+func (a *Auth) dropChallenge(ctx context.Context, log *slog.Logger, challengeId uuid.UUID) {
+	if err := a.twoFactor.DeleteLoginChallenge(ctx, challengeId); err != nil {
+		log.Warn("failed to delete login challenge", sl.Err(err))
+	}
+}
+
+// verifySecondFactor checks a TOTP code, each of them is accepted once,
+// or an unused recovery code, which is used up.
+func (a *Auth) verifySecondFactor(ctx context.Context, state *domain.UserTOTP, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if state.Enabled && len(code) < recoveryCodeLength && strings.Trim(code, "0123456789") == "" {
+		key, err := a.openTOTP(ctx, state)
+		if err != nil {
+			return false, err
+		}
+		counter, ok := key.Validate(code, time.Now(), totpSkew)
+		if !ok {
+			return false, nil
+		}
+		return a.twoFactor.UseTOTPCounter(ctx, state.UserId, counter)
+	}
+
+	normalized := normalizeRecoveryCode(code)
+	if len(normalized) != recoveryCodeLength {
+		return false, nil
+	}
+	codes, err := a.twoFactor.GetRecoveryCodes(ctx, state.UserId)
+	if err != nil {
+		return false, err
+	}
+	for _, recovery := range codes {
+		if bcrypt.CompareHashAndPassword(recovery.Hash, []byte(normalized)) == nil {
+			return a.twoFactor.UseRecoveryCode(ctx, recovery.ID)
+		}
+	}
+	return false, nil
+}
+
+func (a *Auth) openTOTP(ctx context.Context, state *domain.UserTOTP) (*totp.Key, error) {
+	cipher, err := a.keyProvider.UserCipher(ctx, state.UserId)
+	if err != nil {
+		return nil, err
+	}
+	uri, err := cipher.DecryptString(state.Secret, state.UserId[:])
+	if err != nil {
+		return nil, err
+	}
+	return totp.Parse(uri)
+}
+
+// newRecoveryCodes returns n codes formatted as XXXXX-XXXXX and bcrypt hashes of their normalized form.
+func newRecoveryCodes(n int) ([]string, [][]byte, error) {
+	codes := make([]string, 0, n)
+	hashes := make([][]byte, 0, n)
+	max := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	for i := 0; i < n; i++ {
+		var b strings.Builder
+		for j := 0; j < recoveryCodeLength; j++ {
+			idx, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, nil, err
+			}
+			b.WriteByte(recoveryCodeAlphabet[idx.Int64()])
+		}
+		code := b.String()
+		hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, nil, err
+		}
+		codes = append(codes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+		hashes = append(hashes, hash)
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
@@ -0,0 +1,244 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"github.com/s0vunia/password-manager/internal/lib/webauthn"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"log/slog"
+	"strings"
+)
+
+const maxCredentialNameLength = 64
+
+var (
+	ErrWebAuthnNotConfigured = errors.New("security keys are not configured")
+	ErrInvalidCredentialName = errors.New("security key name must be 1 to 64 characters")
+)
+
+// BeginWebAuthnRegistration starts registration of a security key and returns JSON options
+// for navigator.credentials.create. The ceremony must be finished within the challenge TTL.
+func (a *Auth) BeginWebAuthnRegistration(ctx context.Context, userId uuid.UUID) ([]byte, error) {
+	const op = "Auth.BeginWebAuthnRegistration"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to begin webauthn registration")
+
+	if !a.tfa.WebAuthn.Enabled() {
+		return nil, fmt.Errorf("%s: %w", op, ErrWebAuthnNotConfigured)
+	}
+
+	state, err := a.twoFactor.GetTOTP(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	credentials, err := a.twoFactor.GetWebAuthnCredentials(ctx, userId)
+	if err != nil {
+		log.Error("failed to get webauthn credentials", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	exclude := make([][]byte, 0, len(credentials))
+	for _, credential := range credentials {
+		exclude = append(exclude, credential.CredentialID)
+	}
+
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		log.Error("failed to generate challenge", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := a.twoFactor.SaveWebAuthnRegistration(ctx, userId, challenge, a.tfa.ChallengeTTL); err != nil {
+		log.Error("failed to save webauthn registration", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	options, err := a.tfa.WebAuthn.CreationOptions(challenge, userId[:], state.Login, exclude)
+	if err != nil {
+		log.Error("failed to encode webauthn options", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return options, nil
+}
+
+// FinishWebAuthnRegistration verifies the response of navigator.credentials.create and stores the key.
+// Recovery codes are returned if it's the first second factor of the user, they're shown only once.
+func (a *Auth) FinishWebAuthnRegistration(
+	ctx context.Context,
+	userId uuid.UUID,
+	name string,
+	clientDataJSON []byte,
+	attestationObject []byte,
+) (*domain.WebAuthnCredential, []string, error) {
+	const op = "Auth.FinishWebAuthnRegistration"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to finish webauthn registration")
+
+	if !a.tfa.WebAuthn.Enabled() {
+		return nil, nil, fmt.Errorf("%s: %w", op, ErrWebAuthnNotConfigured)
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxCredentialNameLength {
+		return nil, nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentialName)
+	}
+
+	challenge, err := a.twoFactor.TakeWebAuthnRegistration(ctx, userId)
+	if err != nil {
+		if errors.Is(err, repositories.ErrChallengeNotFound) {
+			log.Info("webauthn registration not found")
+
+			return nil, nil, fmt.Errorf("%s: %w", op, ErrInvalidChallenge)
+		}
+		log.Error("failed to get webauthn registration", sl.Err(err))
+
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	verified, err := a.tfa.WebAuthn.VerifyRegistration(challenge, clientDataJSON, attestationObject)
+	if err != nil {
+		log.Info("invalid webauthn registration", sl.Err(err))
+
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var codes []string
+	var hashes [][]byte
+	first, err := a.firstSecondFactor(ctx, userId)
+	if err != nil {
+		log.Error("failed to get second factors", sl.Err(err))
+
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if first {
+		codes, hashes, err = newRecoveryCodes(a.tfa.RecoveryCodes)
+		if err != nil {
+			log.Error("failed to generate recovery codes", sl.Err(err))
+
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	credential := domain.WebAuthnCredential{
+		UserId:       userId,
+		CredentialID: verified.ID,
+		PublicKey:    verified.PublicKey,
+		SignCount:    verified.SignCount,
+		AAGUID:       verified.AAGUID,
+		Name:         name,
+	}
+	credential.ID, err = a.twoFactor.CreateWebAuthnCredential(ctx, credential, hashes)
+	if err != nil {
+		log.Error("failed to save webauthn credential", sl.Err(err))
+
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("webauthn credential registered", slog.String("attestation", verified.Attestation))
+
+	return &credential, codes, nil
+}
+
+func (a *Auth) ListWebAuthnCredentials(ctx context.Context, userId uuid.UUID) ([]domain.WebAuthnCredential, error) {
+	const op = "Auth.ListWebAuthnCredentials"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	credentials, err := a.twoFactor.GetWebAuthnCredentials(ctx, userId)
+	if err != nil {
+		log.Error("failed to get webauthn credentials", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return credentials, nil
+}
+
+func (a *Auth) DeleteWebAuthnCredential(ctx context.Context, userId uuid.UUID, id uuid.UUID) error {
+	const op = "Auth.DeleteWebAuthnCredential"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("credential", id.String()),
+	)
+
+	log.Info("attempting to delete webauthn credential")
+
+	if err := a.twoFactor.DeleteWebAuthnCredential(ctx, userId, id); err != nil {
+		log.Error("failed to delete webauthn credential", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// verifyAssertion checks the security key assertion answering the login challenge.
+// A signature counter which didn't increase rejects the assertion, the key may have been cloned.
+func (a *Auth) verifyAssertion(
+	ctx context.Context,
+	log *slog.Logger,
+	challenge *domain.LoginChallenge,
+	assertion *domain.WebAuthnAssertion,
+) (bool, error) {
+	if challenge.WebAuthnChallenge == nil {
+		return false, nil
+	}
+	credential, err := a.twoFactor.GetWebAuthnCredential(ctx, challenge.UserId, assertion.CredentialID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrWebAuthnCredentialNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	count, err := a.tfa.WebAuthn.VerifyAssertion(challenge.WebAuthnChallenge, webauthn.Credential{
+		ID:        credential.CredentialID,
+		PublicKey: credential.PublicKey,
+		SignCount: credential.SignCount,
+	}, assertion.ClientDataJSON, assertion.AuthenticatorData, assertion.Signature)
+	if err != nil {
+		if errors.Is(err, webauthn.ErrSignCount) {
+			log.Warn("webauthn signature counter did not increase, the key may be cloned",
+				slog.String("credential", credential.ID.String()))
+		} else {
+			log.Info("invalid webauthn assertion", sl.Err(err))
+		}
+		return false, nil
+	}
+	return a.twoFactor.UpdateWebAuthnSignCount(ctx, credential.ID, credential.SignCount, count)
+}
+
+// firstSecondFactor reports whether the user has neither TOTP nor security keys yet.
+func (a *Auth) firstSecondFactor(ctx context.Context, userId uuid.UUID) (bool, error) {
+	state, err := a.twoFactor.GetTOTP(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+	if state.Enabled {
+		return false, nil
+	}
+	credentials, err := a.twoFactor.GetWebAuthnCredentials(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+	return len(credentials) == 0, nil
+}
@@ -0,0 +1,223 @@
+package keys
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/repositories/user"
+	"log/slog"
+)
+
+// IKeyService hands out per-user data encryption keys (DEK).
+// Every DEK is stored wrapped by the server master key, so leaking
+// one user's key material doesn't expose vaults of other users.
+type IKeyService interface {
+	CreateUserKey(ctx context.Context, userId uuid.UUID) error
+	UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error)
+	RotateMasterKey(ctx context.Context, batchSize int) (int64, error)
+}
+
+type Service struct {
+	log         *slog.Logger
+	master      *aes.Keyring
+	keyProvider Provider
+	keySaver    Saver
+	rotator     Rotator
+}
+
+type Provider interface {
+	GetKey(ctx context.Context, userId uuid.UUID) (*domain.UserKey, error)
+}
+
+type Saver interface {
+	SaveKey(ctx context.Context, key domain.UserKey) error
+}
+
+type Rotator interface {
+	StartKeyRotation(ctx context.Context, targetVersion int) (*domain.KeyRotation, error)
+	RewrapKeys(ctx context.Context, rotation domain.KeyRotation, batchSize int, rewrap user.RewrapFunc) (int, error)
+	CompleteKeyRotation(ctx context.Context, rotationId int64) error
+}
+
+func New(
+	log *slog.Logger,
+	master *aes.Keyring,
+	provider Provider,
+	saver Saver,
+	rotator Rotator,
+) *Service {
+	return &Service{
+		log:         log,
+		master:      master,
+		keyProvider: provider,
+		keySaver:    saver,
+		rotator:     rotator,
+	}
+}
+
+// CreateUserKey generates random DEK for the user and stores it wrapped by the master key.
+// It is a no-op if the user already has a key.
+func (s *Service) CreateUserKey(ctx context.Context, userId uuid.UUID) error {
+	const op = "KeyService.CreateUserKey"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("generating user key")
+
+	if _, err := s.createUserKey(ctx, userId); err != nil {
+		log.Error("failed to create user key", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// UserCipher returns cipher keyed with the user's DEK.
+// Users registered before key hierarchy was introduced get their key on first use.
+func (s *Service) UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error) {
+	const op = "KeyService.UserCipher"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	userKey, err := s.keyProvider.GetKey(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var key []byte
+	if userKey.WrappedKey == "" {
+		log.Info("user has no key yet, generating")
+
+		key, err = s.createUserKey(ctx, userId)
+	} else {
+		key, err = s.unwrap(*userKey)
+	}
+	if err != nil {
+		log.Error("failed to unwrap user key", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := aes.New(key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return cipher, nil
+}
+
+// createUserKey returns unwrapped DEK of the user, generating it if needed.
+// When a concurrent request stores a key first, that key wins.
+func (s *Service) createUserKey(ctx context.Context, userId uuid.UUID) ([]byte, error) {
+	key := make([]byte, aes.KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	version, master := s.master.Current()
+	wrappedKey, err := master.Encrypt(key, userId[:])
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.keySaver.SaveKey(ctx, domain.UserKey{UserId: userId, WrappedKey: wrappedKey, Version: version})
+	if err == nil {
+		return key, nil
+	}
+	if !errors.Is(err, repositories.ErrUserKeyExists) {
+		return nil, err
+	}
+
+	userKey, err := s.keyProvider.GetKey(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if userKey.WrappedKey == "" {
+		return nil, repositories.ErrUserNotFound
+	}
+	return s.unwrap(*userKey)
+}
+
+// unwrap opens user key with the master key version it was sealed with,
+// so keys stay readable while a rotation is in progress.
+func (s *Service) unwrap(userKey domain.UserKey) ([]byte, error) {
+	master, err := s.master.Cipher(userKey.Version)
+	if err != nil {
+		return nil, err
+	}
+	return master.Decrypt(userKey.WrappedKey, userKey.UserId[:])
+}
+
+// RotateMasterKey re-wraps every user key with the current master key version
+// in batches of batchSize and returns the total number of re-wrapped keys.
+// Progress is persisted after each batch, so a crashed rotation resumes
+// where it stopped when called again.
+func (s *Service) RotateMasterKey(ctx context.Context, batchSize int) (int64, error) {
+	const op = "KeyService.RotateMasterKey"
+
+	version, master := s.master.Current()
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("version", version),
+	)
+
+	rotation, err := s.rotator.StartKeyRotation(ctx, version)
+	if err != nil {
+		log.Error("failed to start rotation", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("rotating master key", slog.Int64("rotation", rotation.ID), slog.Int64("rewrapped", rotation.Rewrapped))
+
+	rewrap := func(userKey domain.UserKey) (string, error) {
+		key, err := s.unwrap(userKey)
+		if err != nil {
+			return "", err
+		}
+		return master.Encrypt(key, userKey.UserId[:])
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return rotation.Rewrapped, fmt.Errorf("%s: %w", op, err)
+		}
+
+		n, err := s.rotator.RewrapKeys(ctx, *rotation, batchSize, rewrap)
+		if err != nil {
+			log.Error("failed to rewrap keys", sl.Err(err))
+
+			return rotation.Rewrapped, fmt.Errorf("%s: %w", op, err)
+		}
+		if n == 0 {
+			break
+		}
+		rotation.Rewrapped += int64(n)
+
+		log.Info("batch rewrapped", slog.Int("batch", n), slog.Int64("rewrapped", rotation.Rewrapped))
+	}
+
+	if err := s.rotator.CompleteKeyRotation(ctx, rotation.ID); err != nil {
+		log.Error("failed to complete rotation", sl.Err(err))
+
+		return rotation.Rewrapped, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("master key rotated", slog.Int64("rewrapped", rotation.Rewrapped))
+
+	return rotation.Rewrapped, nil
+}
@@ -0,0 +1,205 @@
+package keys
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"github.com/s0vunia/password-manager/internal/repositories/user"
+	"io"
+	"log/slog"
+	"sort"
+	"testing"
+)
+
+var errInterrupted = errors.New("interrupted")
+
+// memoryStore keeps user keys and rotations in memory, failAfter > 0 makes RewrapKeys
+// fail once after that many batches, like a crashed rotation.
+type memoryStore struct {
+	keys      map[uuid.UUID]domain.UserKey
+	rotation  *domain.KeyRotation
+	completed bool
+	batches   int
+	failAfter int
+}
+
+func (m *memoryStore) GetKey(ctx context.Context, userId uuid.UUID) (*domain.UserKey, error) {
+	key := m.keys[userId]
+	key.UserId = userId
+	return &key, nil
+}
+
+func (m *memoryStore) SaveKey(ctx context.Context, key domain.UserKey) error {
+	if m.keys[key.UserId].WrappedKey != "" {
+		return repositories.ErrUserKeyExists
+	}
+	m.keys[key.UserId] = key
+	return nil
+}
+
+func (m *memoryStore) StartKeyRotation(ctx context.Context, targetVersion int) (*domain.KeyRotation, error) {
+	if m.rotation == nil || m.completed || m.rotation.TargetVersion != targetVersion {
+		m.rotation = &domain.KeyRotation{ID: 1, TargetVersion: targetVersion}
+		m.completed = false
+	}
+	rotation := *m.rotation
+	return &rotation, nil
+}
+
+func (m *memoryStore) RewrapKeys(ctx context.Context, rotation domain.KeyRotation, batchSize int, rewrap user.RewrapFunc) (int, error) {
+	if m.failAfter > 0 && m.batches == m.failAfter {
+		m.failAfter = 0
+		return 0, errInterrupted
+	}
+	m.batches++
+
+	var pending []uuid.UUID
+	for id, key := range m.keys {
+		if key.Version != rotation.TargetVersion {
+			pending = append(pending, id)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].String() < pending[j].String() })
+	if len(pending) > batchSize {
+		pending = pending[:batchSize]
+	}
+	for _, id := range pending {
+		key := m.keys[id]
+		key.UserId = id
+		wrapped, err := rewrap(key)
+		if err != nil {
+			return 0, err
+		}
+		m.keys[id] = domain.UserKey{UserId: id, WrappedKey: wrapped, Version: rotation.TargetVersion}
+	}
+	m.rotation.Rewrapped += int64(len(pending))
+	return len(pending), nil
+}
+
+func (m *memoryStore) CompleteKeyRotation(ctx context.Context, rotationId int64) error {
+	m.completed = true
+	return nil
+}
+
+func masterKey(version int) []byte {
+	key := make([]byte, aes.KeySize)
+	key[0] = byte(version)
+	return key
+}
+
+func newKeyring(t *testing.T, current int) *aes.Keyring {
+	t.Helper()
+	keys := map[int][]byte{}
+	for version := 1; version <= current; version++ {
+		keys[version] = masterKey(version)
+	}
+	ring, err := aes.NewKeyring(current, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ring
+}
+
+func newService(ring *aes.Keyring, store *memoryStore) *Service {
+	return New(slog.New(slog.NewTextHandler(io.Discard, nil)), ring, store, store, store)
+}
+
+func TestRotateMasterKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		users     int
+		batchSize int
+		failAfter int
+	}{
+		{name: "no users", users: 0, batchSize: 2},
+		{name: "single batch", users: 3, batchSize: 10},
+		{name: "several batches", users: 7, batchSize: 2},
+		{name: "resumed after a crash", users: 7, batchSize: 2, failAfter: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &memoryStore{keys: map[uuid.UUID]domain.UserKey{}, failAfter: tt.failAfter}
+			before := newService(newKeyring(t, 1), store)
+			vault := map[uuid.UUID]string{}
+			for i := 0; i < tt.users; i++ {
+				userId := uuid.New()
+				cipher, err := before.UserCipher(context.Background(), userId)
+				if err != nil {
+					t.Fatal(err)
+				}
+				vault[userId], err = cipher.EncryptString("secret", userId[:])
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			after := newService(newKeyring(t, 2), store)
+			rewrapped, err := after.RotateMasterKey(context.Background(), tt.batchSize)
+			if tt.failAfter > 0 {
+				if !errors.Is(err, errInterrupted) {
+					t.Fatalf("RotateMasterKey() error = %v, want %v", err, errInterrupted)
+				}
+				if store.completed {
+					t.Fatal("interrupted rotation is completed")
+				}
+				rewrapped, err = after.RotateMasterKey(context.Background(), tt.batchSize)
+			}
+			if err != nil {
+				t.Fatalf("RotateMasterKey() error = %v", err)
+			}
+			if rewrapped != int64(tt.users) {
+				t.Errorf("RotateMasterKey() = %d, want %d", rewrapped, tt.users)
+			}
+			if !store.completed {
+				t.Error("rotation isn't completed")
+			}
+
+			// Only the new master key is needed once the rotation is done.
+			onlyNew, err := aes.NewKeyring(2, map[int][]byte{2: masterKey(2)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			rotated := newService(onlyNew, store)
+			for userId, sealed := range vault {
+				if store.keys[userId].Version != 2 {
+					t.Errorf("key of %s is on version %d", userId, store.keys[userId].Version)
+				}
+				cipher, err := rotated.UserCipher(context.Background(), userId)
+				if err != nil {
+					t.Fatalf("UserCipher() error = %v", err)
+				}
+				if got, err := cipher.DecryptString(sealed, userId[:]); err != nil || got != "secret" {
+					t.Errorf("vault data of %s opens to %q, %v", userId, got, err)
+				}
+			}
+		})
+	}
+}
+
+func TestUserCipherKeepsExistingKey(t *testing.T) {
+	store := &memoryStore{keys: map[uuid.UUID]domain.UserKey{}}
+	s := newService(newKeyring(t, 1), store)
+	userId := uuid.New()
+
+	first, err := s.UserCipher(context.Background(), userId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := first.EncryptString("secret", userId[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateUserKey(context.Background(), userId); err != nil {
+		t.Fatalf("CreateUserKey() error = %v", err)
+	}
+	second, err := s.UserCipher(context.Background(), userId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := second.DecryptString(sealed, userId[:]); err != nil || got != "secret" {
+		t.Errorf("DecryptString() = %q, %v", got, err)
+	}
+}
@@ -0,0 +1,235 @@
+package apiKeyItem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+var (
+	ErrEmptySecret = errors.New("api key secret is empty")
+)
+
+type IAPIKeyItemService interface {
+	CreateAPIKeyItem(ctx context.Context, item domain.APIKeyItem) (uuid.UUID, error)
+	GetAPIKeyItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.APIKeyItem, error)
+	GetAPIKeyItems(ctx context.Context, userId uuid.UUID) ([]*domain.APIKeyItem, error)
+	UpdateAPIKeyItem(ctx context.Context, item domain.APIKeyItem) error
+	DeleteAPIKeyItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
+
+type Service struct {
+	log                *slog.Logger
+	apiKeyItemSaver    Saver
+	apiKeyItemProvider Provider
+	keyProvider        KeyProvider
+}
+
+type Saver interface {
+	CreateAPIKeyItem(ctx context.Context, item domain.APIKeyItem) (uuid.UUID, error)
+	UpdateAPIKeyItem(ctx context.Context, item domain.APIKeyItem) error
+}
+
+type Provider interface {
+	GetAPIKeyItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.APIKeyItem, error)
+	GetAPIKeyItems(ctx context.Context, userId uuid.UUID) ([]*domain.APIKeyItem, error)
+	DeleteAPIKeyItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
+
+// KeyProvider returns cipher keyed with the user's data encryption key.
+type KeyProvider interface {
+	UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error)
+}
+
+func New(
+	log *slog.Logger,
+	saver Saver,
+	provider Provider,
+	keyProvider KeyProvider,
+) *Service {
+	return &Service{
+		log:                log,
+		apiKeyItemSaver:    saver,
+		apiKeyItemProvider: provider,
+		keyProvider:        keyProvider,
+	}
+}
+
+func (a *Service) CreateAPIKeyItem(ctx context.Context, item domain.APIKeyItem) (uuid.UUID, error) {
+	const op = "APIKeyItemService.CreateAPIKeyItem"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", item.UserId.String()),
+		slog.String("name", item.Name),
+	)
+
+	log.Info("attempting to create API key item")
+
+	if err := a.prepare(ctx, &item); err != nil {
+		log.Info("failed to prepare API key item", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := a.apiKeyItemSaver.CreateAPIKeyItem(ctx, item)
+	if err != nil {
+		log.Error("failed to create API key item", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (a *Service) GetAPIKeyItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.APIKeyItem, error) {
+	const op = "APIKeyItemService.GetAPIKeyItem"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to get API key item")
+	item, err := a.apiKeyItemProvider.GetAPIKeyItem(ctx, itemId, userId)
+	if err != nil {
+		log.Error("failed to get API key item", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := a.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := decrypt(cipher, item); err != nil {
+		log.Error("failed to decrypt API key item", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return item, nil
+}
+
+func (a *Service) GetAPIKeyItems(ctx context.Context, userId uuid.UUID) ([]*domain.APIKeyItem, error) {
+	const op = "APIKeyItemService.GetAPIKeyItems"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to get API key items")
+	items, err := a.apiKeyItemProvider.GetAPIKeyItems(ctx, userId)
+	if err != nil {
+		log.Error("failed to get API key items", sl.Err(err))
+
+		return make([]*domain.APIKeyItem, 0), fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := a.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return make([]*domain.APIKeyItem, 0), fmt.Errorf("%s: %w", op, err)
+	}
+	for _, item := range items {
+		if err := decrypt(cipher, item); err != nil {
+			log.Error("failed to decrypt API key item", sl.Err(err), slog.String("item", item.ID.String()))
+
+			return make([]*domain.APIKeyItem, 0), fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return items, nil
+}
+
+func (a *Service) UpdateAPIKeyItem(ctx context.Context, item domain.APIKeyItem) error {
+	const op = "APIKeyItemService.UpdateAPIKeyItem"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", item.UserId.String()),
+		slog.String("item", item.ID.String()),
+	)
+
+	log.Info("attempting to update API key item")
+
+	if err := a.prepare(ctx, &item); err != nil {
+		log.Info("failed to prepare API key item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := a.apiKeyItemSaver.UpdateAPIKeyItem(ctx, item); err != nil {
+		log.Error("failed to update API key item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (a *Service) DeleteAPIKeyItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error {
+	const op = "APIKeyItemService.DeleteAPIKeyItem"
+
+	log := a.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to delete API key item")
+	if err := a.apiKeyItemProvider.DeleteAPIKeyItem(ctx, userId, itemId); err != nil {
+		log.Error("failed to delete API key item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// prepare validates API key, builds summary from the key id and expiry date
+// and encrypts the secret.
+func (a *Service) prepare(ctx context.Context, item *domain.APIKeyItem) error {
+	item.Secret = strings.TrimSpace(item.Secret)
+	if item.Secret == "" {
+		return ErrEmptySecret
+	}
+
+	item.Type = domain.ItemTypeAPIKey
+	item.Summary = summary(item)
+
+	cipher, err := a.keyProvider.UserCipher(ctx, item.UserId)
+	if err != nil {
+		return err
+	}
+	item.Secret, err = cipher.EncryptString(item.Secret, item.UserId[:])
+	return err
+}
+
+// summary never includes the secret itself, only public key id and expiry date.
+func summary(item *domain.APIKeyItem) string {
+	parts := make([]string, 0, 3)
+	if item.Service != "" {
+		parts = append(parts, item.Service)
+	}
+	if item.KeyId != "" {
+		parts = append(parts, item.KeyId)
+	}
+	if item.ExpiresAt != nil {
+		parts = append(parts, "expires "+item.ExpiresAt.UTC().Format(time.DateOnly))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func decrypt(cipher *aes.Cipher, item *domain.APIKeyItem) error {
+	var err error
+	item.Secret, err = cipher.DecryptString(item.Secret, item.UserId[:])
+	return err
+}
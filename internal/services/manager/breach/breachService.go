@@ -0,0 +1,134 @@
+package breach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/hibp"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"log/slog"
+)
+
+var (
+	ErrCorpusUnavailable = errors.New("breach corpus is not configured")
+	ErrZeroKnowledge     = errors.New("vault is encrypted on the client and can't be checked")
+)
+
+type IBreachService interface {
+	CheckBreaches(ctx context.Context, userId uuid.UUID) (*domain.BreachReport, error)
+	CheckNewPassword(ctx context.Context, password string) (int64, error)
+}
+
+type Service struct {
+	log               *slog.Logger
+	corpus            hibp.Corpus
+	loginItemProvider LoginItemProvider
+	userProvider      UserProvider
+	warnOnCreate      bool
+}
+
+// LoginItemProvider returns login items with decrypted passwords, loginItem.Service is one.
+type LoginItemProvider interface {
+	GetLoginItems(ctx context.Context, userId uuid.UUID) ([]*domain.LoginItem, error)
+}
+
+type UserProvider interface {
+	IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error)
+}
+
+// New returns the breach checker, nil corpus disables it.
+// warnOnCreate enables checking passwords of new login items.
+func New(
+	log *slog.Logger,
+	corpus hibp.Corpus,
+	loginItemProvider LoginItemProvider,
+	userProvider UserProvider,
+	warnOnCreate bool,
+) *Service {
+	return &Service{
+		log:               log,
+		corpus:            corpus,
+		loginItemProvider: loginItemProvider,
+		userProvider:      userProvider,
+		warnOnCreate:      warnOnCreate,
+	}
+}
+
+// CheckBreaches looks up passwords of the user's login items in the breach corpus.
+func (s *Service) CheckBreaches(ctx context.Context, userId uuid.UUID) (*domain.BreachReport, error) {
+	const op = "BreachService.CheckBreaches"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to check breaches")
+
+	if s.corpus == nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrCorpusUnavailable)
+	}
+	zk, err := s.userProvider.IsZeroKnowledge(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if zk {
+		return nil, fmt.Errorf("%s: %w", op, ErrZeroKnowledge)
+	}
+
+	items, err := s.loginItemProvider.GetLoginItems(ctx, userId)
+	if err != nil {
+		log.Error("failed to get login items", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	report := &domain.BreachReport{Checked: len(items)}
+	// Reused passwords are looked up once.
+	counts := make(map[string]int64)
+	for _, item := range items {
+		count, ok := counts[item.EncryptPassword]
+		if !ok {
+			count, err = hibp.Count(s.corpus, item.EncryptPassword)
+			if err != nil {
+				log.Error("failed to look up password", sl.Err(err), slog.String("item", item.ID.String()))
+
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			counts[item.EncryptPassword] = count
+		}
+		if count > 0 {
+			report.Breached = append(report.Breached, &domain.BreachFinding{
+				ItemId:      item.Item.ID,
+				LoginItemId: item.ID,
+				Name:        item.Name,
+				Count:       count,
+			})
+		}
+	}
+	if len(report.Breached) > 0 {
+		log.Warn("breached passwords found", slog.Int("items", len(report.Breached)))
+	}
+	return report, nil
+}
+
+// CheckNewPassword returns how many times the password of a new login item was seen in breaches.
+// It returns 0 without a lookup if the check on create is disabled.
+func (s *Service) CheckNewPassword(ctx context.Context, password string) (int64, error) {
+	const op = "BreachService.CheckNewPassword"
+
+	if s.corpus == nil || !s.warnOnCreate {
+		return 0, nil
+	}
+	count, err := hibp.Count(s.corpus, password)
+	if err != nil {
+		s.log.Error("failed to look up password", slog.String("op", op), sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return count, nil
+}
@@ -0,0 +1,304 @@
+package cardItem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/card"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"log/slog"
+	"time"
+)
+
+var (
+	ErrInvalidCardNumber = errors.New("invalid card number")
+	ErrInvalidExpiry     = errors.New("invalid card expiry date")
+	ErrCardExpired       = errors.New("card is expired")
+	ErrInvalidCVV        = errors.New("invalid card cvv")
+)
+
+type ICardItemService interface {
+	CreateCardItem(ctx context.Context, item domain.CardItem) (uuid.UUID, error)
+	GetCardItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.CardItem, error)
+	GetCardItems(ctx context.Context, userId uuid.UUID) ([]*domain.CardItem, error)
+	UpdateCardItem(ctx context.Context, item domain.CardItem) (int64, error)
+	DeleteCardItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
+
+type Service struct {
+	log              *slog.Logger
+	cardItemSaver    Saver
+	cardItemProvider Provider
+	keyProvider      KeyProvider
+	userProvider     UserProvider
+}
+
+type Saver interface {
+	CreateCardItem(ctx context.Context, item domain.CardItem) (uuid.UUID, error)
+	UpdateCardItem(ctx context.Context, item domain.CardItem) (int64, error)
+}
+
+type Provider interface {
+	GetCardItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.CardItem, error)
+	GetCardItems(ctx context.Context, userId uuid.UUID) ([]*domain.CardItem, error)
+	DeleteCardItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
+
+// KeyProvider returns cipher keyed with the user's data encryption key.
+type KeyProvider interface {
+	UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error)
+}
+
+// UserProvider tells whether the user's vault is encrypted on the client.
+type UserProvider interface {
+	IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error)
+}
+
+func New(
+	log *slog.Logger,
+	saver Saver,
+	provider Provider,
+	keyProvider KeyProvider,
+	userProvider UserProvider,
+) *Service {
+	return &Service{
+		log:              log,
+		cardItemSaver:    saver,
+		cardItemProvider: provider,
+		keyProvider:      keyProvider,
+		userProvider:     userProvider,
+	}
+}
+
+func (c *Service) CreateCardItem(ctx context.Context, item domain.CardItem) (uuid.UUID, error) {
+	const op = "CardItemService.CreateCardItem"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("user", item.UserId.String()),
+		slog.String("name", item.Name),
+	)
+
+	log.Info("attempting to create card item")
+
+	if err := c.prepare(ctx, &item, nil); err != nil {
+		log.Info("failed to prepare card item", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := c.cardItemSaver.CreateCardItem(ctx, item)
+	if err != nil {
+		log.Error("failed to create card item", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (c *Service) GetCardItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.CardItem, error) {
+	const op = "CardItemService.GetCardItem"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to get card item")
+	item, err := c.cardItemProvider.GetCardItem(ctx, itemId, userId)
+	if err != nil {
+		log.Error("failed to get card item", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := c.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := decrypt(cipher, item); err != nil {
+		log.Error("failed to decrypt card item", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return item, nil
+}
+
+func (c *Service) GetCardItems(ctx context.Context, userId uuid.UUID) ([]*domain.CardItem, error) {
+	const op = "CardItemService.GetCardItems"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to get card items")
+	items, err := c.cardItemProvider.GetCardItems(ctx, userId)
+	if err != nil {
+		log.Error("failed to get card items", sl.Err(err))
+
+		return make([]*domain.CardItem, 0), fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := c.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return make([]*domain.CardItem, 0), fmt.Errorf("%s: %w", op, err)
+	}
+	for _, item := range items {
+		if err := decrypt(cipher, item); err != nil {
+			log.Error("failed to decrypt card item", sl.Err(err), slog.String("item", item.ID.String()))
+
+			return make([]*domain.CardItem, 0), fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return items, nil
+}
+
+// UpdateCardItem replaces the card item if its revision is still item.Revision
+// and returns the new revision.
+func (c *Service) UpdateCardItem(ctx context.Context, item domain.CardItem) (int64, error) {
+	const op = "CardItemService.UpdateCardItem"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("user", item.UserId.String()),
+		slog.String("item", item.ID.String()),
+		slog.Int64("revision", item.Revision),
+	)
+
+	log.Info("attempting to update card item")
+
+	stored, err := c.cardItemProvider.GetCardItem(ctx, item.ID, item.UserId)
+	if err != nil {
+		log.Error("failed to get card item", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := c.prepare(ctx, &item, stored); err != nil {
+		log.Info("failed to prepare card item", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	revision, err := c.cardItemSaver.UpdateCardItem(ctx, item)
+	if err != nil {
+		log.Error("failed to update card item", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return revision, nil
+}
+
+func (c *Service) DeleteCardItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error {
+	const op = "CardItemService.DeleteCardItem"
+
+	log := c.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to delete card item")
+	if err := c.cardItemProvider.DeleteCardItem(ctx, userId, itemId); err != nil {
+		log.Error("failed to delete card item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// prepare validates card, fills brand and masked number summary
+// and encrypts sensitive fields. Card details of zero-knowledge users are
+// encrypted on the client, so they're stored as sent, without validation and summary.
+// stored is the card being updated, nil on create: an expired card can still be
+// edited as long as its expiry date isn't changed.
+func (c *Service) prepare(ctx context.Context, item *domain.CardItem, stored *domain.CardItem) error {
+	zk, err := c.userProvider.IsZeroKnowledge(ctx, item.UserId)
+	if err != nil {
+		return err
+	}
+
+	item.Type = domain.ItemTypeCard
+	if !zk {
+		err := validate(item, time.Now())
+		if errors.Is(err, ErrCardExpired) && stored != nil &&
+			stored.ExpiryMonth == item.ExpiryMonth && stored.ExpiryYear == item.ExpiryYear {
+			err = nil
+		}
+		if err != nil {
+			return err
+		}
+		if item.Brand == "" {
+			item.Brand = card.Brand(item.Number)
+		}
+		item.Summary = card.Mask(item.Number)
+	}
+
+	cipher, err := c.keyProvider.UserCipher(ctx, item.UserId)
+	if err != nil {
+		return err
+	}
+	for _, field := range []*string{&item.Cardholder, &item.Number, &item.CVV} {
+		*field, err = cipher.EncryptString(*field, item.UserId[:])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate normalizes card number and checks it with Luhn algorithm,
+// checks that the card isn't expired at now and CVV is 3 or 4 digits.
+func validate(item *domain.CardItem, now time.Time) error {
+	item.Number = card.Normalize(item.Number)
+	if !card.Luhn(item.Number) {
+		return ErrInvalidCardNumber
+	}
+
+	if item.ExpiryMonth < 1 || item.ExpiryMonth > 12 {
+		return ErrInvalidExpiry
+	}
+	if item.ExpiryYear < 100 {
+		item.ExpiryYear += 2000
+	}
+	if item.ExpiryYear > 9999 {
+		return ErrInvalidExpiry
+	}
+	if item.CVV != "" {
+		if len(item.CVV) < 3 || len(item.CVV) > 4 {
+			return ErrInvalidCVV
+		}
+		for _, d := range item.CVV {
+			if d < '0' || d > '9' {
+				return ErrInvalidCVV
+			}
+		}
+	}
+
+	// Checked last, so ErrCardExpired means the card is otherwise valid.
+	// Card is valid through the last day of the expiry month.
+	expiresAt := time.Date(item.ExpiryYear, time.Month(item.ExpiryMonth)+1, 1, 0, 0, 0, 0, time.UTC)
+	if !now.Before(expiresAt) {
+		return ErrCardExpired
+	}
+	return nil
+}
+
+func decrypt(cipher *aes.Cipher, item *domain.CardItem) error {
+	var err error
+	for _, field := range []*string{&item.Cardholder, &item.Number, &item.CVV} {
+		*field, err = cipher.DecryptString(*field, item.UserId[:])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package cardItem
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/card"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+type staticKey struct {
+	cipher *aes.Cipher
+}
+
+func (k staticKey) UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error) {
+	return k.cipher, nil
+}
+
+type zeroKnowledgeUsers map[uuid.UUID]bool
+
+func (z zeroKnowledgeUsers) IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error) {
+	return z[userId], nil
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Date(2024, time.June, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		item       domain.CardItem
+		wantErr    error
+		wantNumber string
+		wantYear   int
+	}{
+		{name: "valid", item: domain.CardItem{Number: "4111111111111111", ExpiryMonth: 12, ExpiryYear: 2026, CVV: "123"},
+			wantNumber: "4111111111111111", wantYear: 2026},
+		{name: "grouped number", item: domain.CardItem{Number: "4111 1111-1111 1111", ExpiryMonth: 1, ExpiryYear: 2030},
+			wantNumber: "4111111111111111", wantYear: 2030},
+		{name: "two digit year", item: domain.CardItem{Number: "378282246310005", ExpiryMonth: 7, ExpiryYear: 27, CVV: "1234"},
+			wantNumber: "378282246310005", wantYear: 2027},
+		{name: "expires this month", item: domain.CardItem{Number: "4111111111111111", ExpiryMonth: 6, ExpiryYear: 2024},
+			wantNumber: "4111111111111111", wantYear: 2024},
+		{name: "expired last month", item: domain.CardItem{Number: "4111111111111111", ExpiryMonth: 5, ExpiryYear: 2024},
+			wantErr: ErrCardExpired},
+		{name: "expired last year", item: domain.CardItem{Number: "4111111111111111", ExpiryMonth: 12, ExpiryYear: 23},
+			wantErr: ErrCardExpired},
+		{name: "bad check digit", item: domain.CardItem{Number: "4111111111111112", ExpiryMonth: 12, ExpiryYear: 2026},
+			wantErr: ErrInvalidCardNumber},
+		{name: "letters in number", item: domain.CardItem{Number: "4111-1111-1111-111x", ExpiryMonth: 12, ExpiryYear: 2026},
+			wantErr: ErrInvalidCardNumber},
+		{name: "month zero", item: domain.CardItem{Number: "4111111111111111", ExpiryMonth: 0, ExpiryYear: 2026},
+			wantErr: ErrInvalidExpiry},
+		{name: "month thirteen", item: domain.CardItem{Number: "4111111111111111", ExpiryMonth: 13, ExpiryYear: 2026},
+			wantErr: ErrInvalidExpiry},
+		{name: "five digit year", item: domain.CardItem{Number: "4111111111111111", ExpiryMonth: 1, ExpiryYear: 10000},
+			wantErr: ErrInvalidExpiry},
+		{name: "short cvv", item: domain.CardItem{Number: "4111111111111111", ExpiryMonth: 12, ExpiryYear: 2026, CVV: "12"},
+			wantErr: ErrInvalidCVV},
+		{name: "long cvv", item: domain.CardItem{Number: "4111111111111111", ExpiryMonth: 12, ExpiryYear: 2026, CVV: "12345"},
+			wantErr: ErrInvalidCVV},
+		{name: "cvv with letters", item: domain.CardItem{Number: "4111111111111111", ExpiryMonth: 12, ExpiryYear: 2026, CVV: "12a"},
+			wantErr: ErrInvalidCVV},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := tt.item
+			err := validate(&item, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("validate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if item.Number != tt.wantNumber {
+				t.Errorf("Number = %q, want %q", item.Number, tt.wantNumber)
+			}
+			if item.ExpiryYear != tt.wantYear {
+				t.Errorf("ExpiryYear = %d, want %d", item.ExpiryYear, tt.wantYear)
+			}
+		})
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	cipher, err := aes.New(make([]byte, aes.KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, zkUser := uuid.New(), uuid.New()
+	s := New(slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, staticKey{cipher}, zeroKnowledgeUsers{zkUser: true})
+
+	expired := &domain.CardItem{ExpiryMonth: 1, ExpiryYear: 2020}
+	tests := []struct {
+		name        string
+		item        domain.CardItem
+		stored      *domain.CardItem
+		wantErr     error
+		wantSummary string
+		wantBrand   string
+	}{
+		{name: "valid card", item: domain.CardItem{Item: domain.Item{UserId: user},
+			Number: "4111 1111 1111 1111", ExpiryMonth: 12, ExpiryYear: 2099, CVV: "123"},
+			wantSummary: card.Mask("4111111111111111"), wantBrand: card.Brand("4111111111111111")},
+		{name: "invalid card", item: domain.CardItem{Item: domain.Item{UserId: user},
+			Number: "4111111111111112", ExpiryMonth: 12, ExpiryYear: 2099},
+			wantErr: ErrInvalidCardNumber},
+		{name: "expired card", item: domain.CardItem{Item: domain.Item{UserId: user},
+			Number: "4111111111111111", ExpiryMonth: 1, ExpiryYear: 2020},
+			wantErr: ErrCardExpired},
+		{name: "update of expired card", item: domain.CardItem{Item: domain.Item{UserId: user},
+			Number: "4111111111111111", ExpiryMonth: 1, ExpiryYear: 20}, stored: expired,
+			wantSummary: card.Mask("4111111111111111"), wantBrand: card.Brand("4111111111111111")},
+		{name: "update to past expiry", item: domain.CardItem{Item: domain.Item{UserId: user},
+			Number: "4111111111111111", ExpiryMonth: 2, ExpiryYear: 2020}, stored: expired,
+			wantErr: ErrCardExpired},
+		{name: "update of expired card with bad cvv", item: domain.CardItem{Item: domain.Item{UserId: user},
+			Number: "4111111111111111", ExpiryMonth: 1, ExpiryYear: 2020, CVV: "1"}, stored: expired,
+			wantErr: ErrInvalidCVV},
+		{name: "zero-knowledge user", item: domain.CardItem{Item: domain.Item{UserId: zkUser},
+			Number: "client-encrypted number", CVV: "client-encrypted cvv"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := tt.item
+			err := s.prepare(context.Background(), &item, tt.stored)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("prepare() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if item.Summary != tt.wantSummary {
+				t.Errorf("Summary = %q, want %q", item.Summary, tt.wantSummary)
+			}
+			if item.Brand != tt.wantBrand {
+				t.Errorf("Brand = %q, want %q", item.Brand, tt.wantBrand)
+			}
+			if err := decrypt(cipher, &item); err != nil {
+				t.Fatalf("decrypt() error = %v", err)
+			}
+			if want := card.Normalize(tt.item.Number); tt.item.UserId == user && item.Number != want {
+				t.Errorf("Number = %q, want %q", item.Number, want)
+			}
+			if tt.item.UserId == zkUser && (item.Number != tt.item.Number || item.CVV != tt.item.CVV) {
+				t.Errorf("client-encrypted fields changed: %q, %q", item.Number, item.CVV)
+			}
+		})
+	}
+}
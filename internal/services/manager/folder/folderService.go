@@ -0,0 +1,191 @@
+package folder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"log/slog"
+	"strings"
+)
+
+var (
+	ErrInvalidFolderPath = errors.New("invalid folder path")
+)
+
+type IFolderService interface {
+	CreateFolder(ctx context.Context, folder domain.Folder) (uuid.UUID, error)
+	GetFolders(ctx context.Context, userId uuid.UUID) ([]*domain.Folder, error)
+	GetFolderByPath(ctx context.Context, userId uuid.UUID, path string) (*domain.Folder, error)
+	RenameFolder(ctx context.Context, folderId, userId uuid.UUID, name string) error
+	MoveFolder(ctx context.Context, folderId, userId, parentId uuid.UUID) error
+	DeleteFolder(ctx context.Context, folderId, userId uuid.UUID, mode domain.FolderDeleteMode) error
+}
+
+type Service struct {
+	log            *slog.Logger
+	folderSaver    Saver
+	folderProvider Provider
+}
+
+type Saver interface {
+	Create(ctx context.Context, folder domain.Folder) (uuid.UUID, error)
+	Rename(ctx context.Context, id, userId uuid.UUID, name string) error
+	Move(ctx context.Context, id, userId, parentId uuid.UUID) error
+	Delete(ctx context.Context, id, userId uuid.UUID, mode domain.FolderDeleteMode) error
+}
+
+type Provider interface {
+	List(ctx context.Context, userId uuid.UUID) ([]*domain.Folder, error)
+	GetByPath(ctx context.Context, userId uuid.UUID, names []string) (*domain.Folder, error)
+}
+
+func New(
+	log *slog.Logger,
+	saver Saver,
+	provider Provider,
+) *Service {
+	return &Service{
+		log:            log,
+		folderSaver:    saver,
+		folderProvider: provider,
+	}
+}
+
+func (f *Service) CreateFolder(ctx context.Context, folder domain.Folder) (uuid.UUID, error) {
+	const op = "FolderService.CreateFolder"
+
+	log := f.log.With(
+		slog.String("op", op),
+		slog.String("user", folder.UserId.String()),
+		slog.String("parent", folder.ParentId.String()),
+	)
+
+	log.Info("attempting to create folder")
+	id, err := f.folderSaver.Create(ctx, folder)
+	if err != nil {
+		log.Error("failed to create folder", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (f *Service) GetFolders(ctx context.Context, userId uuid.UUID) ([]*domain.Folder, error) {
+	const op = "FolderService.GetFolders"
+
+	log := f.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to get folders")
+	folders, err := f.folderProvider.List(ctx, userId)
+	if err != nil {
+		log.Error("failed to get folders", sl.Err(err))
+
+		return make([]*domain.Folder, 0), fmt.Errorf("%s: %w", op, err)
+	}
+	return folders, nil
+}
+
+func (f *Service) RenameFolder(ctx context.Context, folderId, userId uuid.UUID, name string) error {
+	const op = "FolderService.RenameFolder"
+
+	log := f.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("folder", folderId.String()),
+	)
+
+	log.Info("attempting to rename folder")
+	if err := f.folderSaver.Rename(ctx, folderId, userId, name); err != nil {
+		log.Error("failed to rename folder", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// MoveFolder moves the folder with its subtree under parentId, uuid.Nil parentId means the root.
+func (f *Service) MoveFolder(ctx context.Context, folderId, userId, parentId uuid.UUID) error {
+	const op = "FolderService.MoveFolder"
+
+	log := f.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("folder", folderId.String()),
+		slog.String("parent", parentId.String()),
+	)
+
+	log.Info("attempting to move folder")
+	if err := f.folderSaver.Move(ctx, folderId, userId, parentId); err != nil {
+		log.Error("failed to move folder", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// GetFolderByPath resolves path like infra/prod/db starting from the root of the vault.
+func (f *Service) GetFolderByPath(ctx context.Context, userId uuid.UUID, path string) (*domain.Folder, error) {
+	const op = "FolderService.GetFolderByPath"
+
+	log := f.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to resolve folder path")
+	names, err := splitPath(path)
+	if err != nil {
+		log.Info("invalid folder path", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	folder, err := f.folderProvider.GetByPath(ctx, userId, names)
+	if err != nil {
+		log.Error("failed to resolve folder path", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return folder, nil
+}
+
+// DeleteFolder removes the folder with its subtree, items are deleted or moved to the root depending on mode.
+func (f *Service) DeleteFolder(ctx context.Context, folderId, userId uuid.UUID, mode domain.FolderDeleteMode) error {
+	const op = "FolderService.DeleteFolder"
+
+	log := f.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("folder", folderId.String()),
+		slog.Int64("mode", int64(mode)),
+	)
+
+	log.Info("attempting to delete folder")
+	if err := f.folderSaver.Delete(ctx, folderId, userId, mode); err != nil {
+		log.Error("failed to delete folder", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// splitPath splits folder path into names, leading and trailing separators are ignored.
+func splitPath(path string) ([]string, error) {
+	path = strings.Trim(path, domain.FolderPathSeparator)
+	if path == "" {
+		return nil, ErrInvalidFolderPath
+	}
+	names := strings.Split(path, domain.FolderPathSeparator)
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" {
+			return nil, ErrInvalidFolderPath
+		}
+	}
+	return names, nil
+}
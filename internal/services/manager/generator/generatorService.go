@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"github.com/s0vunia/password-manager/internal/lib/passgen"
+	"log/slog"
+)
+
+type IGeneratorService interface {
+	GeneratePassword(ctx context.Context, policy passgen.Policy) (string, float64, error)
+	GeneratePassphrase(ctx context.Context, policy passgen.PassphrasePolicy) (string, float64, error)
+}
+
+type Service struct {
+	log *slog.Logger
+}
+
+func New(log *slog.Logger) *Service {
+	return &Service{
+		log: log,
+	}
+}
+
+// GeneratePassword returns a random password and its entropy in bits, zero length means the default one.
+func (s *Service) GeneratePassword(ctx context.Context, policy passgen.Policy) (string, float64, error) {
+	const op = "GeneratorService.GeneratePassword"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("length", policy.Length),
+	)
+
+	if policy.Length == 0 {
+		policy.Length = passgen.DefaultLength
+	}
+	password, entropy, err := passgen.Generate(policy)
+	if err != nil {
+		log.Error("failed to generate password", sl.Err(err))
+
+		return "", 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return password, entropy, nil
+}
+
+// GeneratePassphrase returns a diceware-style passphrase and its entropy in bits,
+// zero words and an empty separator mean the default ones.
+func (s *Service) GeneratePassphrase(ctx context.Context, policy passgen.PassphrasePolicy) (string, float64, error) {
+	const op = "GeneratorService.GeneratePassphrase"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Int("words", policy.Words),
+	)
+
+	if policy.Words == 0 {
+		policy.Words = passgen.DefaultWords
+	}
+	if policy.Separator == "" {
+		policy.Separator = passgen.DefaultSeparator
+	}
+	passphrase, entropy, err := passgen.Passphrase(policy)
+	if err != nil {
+		log.Error("failed to generate passphrase", sl.Err(err))
+
+		return "", 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return passphrase, entropy, nil
+}
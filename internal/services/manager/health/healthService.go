@@ -0,0 +1,145 @@
+package health
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"github.com/s0vunia/password-manager/internal/lib/strength"
+	"log/slog"
+	"time"
+)
+
+// WeakScore is the highest strength score still reported as weak.
+const WeakScore = 2
+
+var (
+	ErrZeroKnowledge = errors.New("vault is encrypted on the client and can't be analyzed")
+)
+
+type IHealthService interface {
+	VaultHealth(ctx context.Context, userId uuid.UUID, maxAge time.Duration) (*domain.VaultHealth, error)
+}
+
+type Service struct {
+	log               *slog.Logger
+	loginItemProvider LoginItemProvider
+	userProvider      UserProvider
+	maxPasswordAge    time.Duration
+}
+
+// LoginItemProvider returns login items with decrypted passwords, loginItem.Service is one.
+type LoginItemProvider interface {
+	GetLoginItems(ctx context.Context, userId uuid.UUID) ([]*domain.LoginItem, error)
+}
+
+type UserProvider interface {
+	IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error)
+}
+
+func New(
+	log *slog.Logger,
+	loginItemProvider LoginItemProvider,
+	userProvider UserProvider,
+	maxPasswordAge time.Duration,
+) *Service {
+	return &Service{
+		log:               log,
+		loginItemProvider: loginItemProvider,
+		userProvider:      userProvider,
+		maxPasswordAge:    maxPasswordAge,
+	}
+}
+
+// VaultHealth reports weak, reused and old passwords of the user's login items.
+// Passwords older than maxAge are old, zero maxAge means the configured default.
+// The report never contains passwords or their parts.
+func (s *Service) VaultHealth(ctx context.Context, userId uuid.UUID, maxAge time.Duration) (*domain.VaultHealth, error) {
+	const op = "HealthService.VaultHealth"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to build vault health report")
+
+	zk, err := s.userProvider.IsZeroKnowledge(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if zk {
+		return nil, fmt.Errorf("%s: %w", op, ErrZeroKnowledge)
+	}
+
+	items, err := s.loginItemProvider.GetLoginItems(ctx, userId)
+	if err != nil {
+		log.Error("failed to get login items", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if maxAge == 0 {
+		maxAge = s.maxPasswordAge
+	}
+	return report(items, maxAge, time.Now()), nil
+}
+
+func report(items []*domain.LoginItem, maxAge time.Duration, now time.Time) *domain.VaultHealth {
+	// Passwords are compared by digest, so the report doesn't keep another copy of them.
+	digests := make([][sha256.Size]byte, len(items))
+	uses := make(map[[sha256.Size]byte]int)
+	health := &domain.VaultHealth{Total: len(items)}
+	for i, item := range items {
+		estimate := strength.Estimate(item.EncryptPassword, []string{item.Login, item.Name})
+		itemHealth := &domain.ItemHealth{
+			ItemId:      item.Item.ID,
+			LoginItemId: item.ID,
+			Name:        item.Name,
+			Score:       estimate.Score,
+			EntropyBits: estimate.Entropy,
+			Patterns:    estimate.Patterns,
+			PasswordAge: now.Sub(item.PasswordChangedAt),
+		}
+		if estimate.Score <= WeakScore {
+			itemHealth.Issues = append(itemHealth.Issues, domain.HealthIssueWeak)
+			health.Weak++
+		}
+		if maxAge > 0 && itemHealth.PasswordAge > maxAge {
+			itemHealth.Issues = append(itemHealth.Issues, domain.HealthIssueOld)
+			health.Old++
+		}
+		digests[i] = sha256.Sum256([]byte(item.EncryptPassword))
+		uses[digests[i]]++
+		health.Items = append(health.Items, itemHealth)
+	}
+
+	groups := make(map[[sha256.Size]byte]int)
+	healthy := 0
+	for i, itemHealth := range health.Items {
+		if uses[digests[i]] > 1 {
+			group, ok := groups[digests[i]]
+			if !ok {
+				group = len(groups) + 1
+				groups[digests[i]] = group
+			}
+			itemHealth.ReuseGroup = group
+			itemHealth.Issues = append(itemHealth.Issues, domain.HealthIssueReused)
+			health.Reused++
+		}
+		if len(itemHealth.Issues) == 0 {
+			healthy++
+		}
+	}
+
+	health.Score = 100
+	if health.Total > 0 {
+		health.Score = healthy * 100 / health.Total
+	}
+	return health
+}
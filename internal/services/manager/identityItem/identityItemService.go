@@ -0,0 +1,262 @@
+package identityItem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"log/slog"
+	"net/mail"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrEmptyIdentity = errors.New("identity must have a name or an email")
+	ErrInvalidEmail  = errors.New("invalid email")
+	ErrInvalidPhone  = errors.New("invalid phone number")
+)
+
+type IIdentityItemService interface {
+	CreateIdentityItem(ctx context.Context, item domain.IdentityItem) (uuid.UUID, error)
+	GetIdentityItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.IdentityItem, error)
+	GetIdentityItems(ctx context.Context, userId uuid.UUID) ([]*domain.IdentityItem, error)
+	UpdateIdentityItem(ctx context.Context, item domain.IdentityItem) error
+	DeleteIdentityItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
+
+type Service struct {
+	log                  *slog.Logger
+	identityItemSaver    Saver
+	identityItemProvider Provider
+	keyProvider          KeyProvider
+}
+
+type Saver interface {
+	CreateIdentityItem(ctx context.Context, item domain.IdentityItem) (uuid.UUID, error)
+	UpdateIdentityItem(ctx context.Context, item domain.IdentityItem) error
+}
+
+type Provider interface {
+	GetIdentityItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.IdentityItem, error)
+	GetIdentityItems(ctx context.Context, userId uuid.UUID) ([]*domain.IdentityItem, error)
+	DeleteIdentityItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
+
+// KeyProvider returns cipher keyed with the user's data encryption key.
+type KeyProvider interface {
+	UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error)
+}
+
+func New(
+	log *slog.Logger,
+	saver Saver,
+	provider Provider,
+	keyProvider KeyProvider,
+) *Service {
+	return &Service{
+		log:                  log,
+		identityItemSaver:    saver,
+		identityItemProvider: provider,
+		keyProvider:          keyProvider,
+	}
+}
+
+func (i *Service) CreateIdentityItem(ctx context.Context, item domain.IdentityItem) (uuid.UUID, error) {
+	const op = "IdentityItemService.CreateIdentityItem"
+
+	log := i.log.With(
+		slog.String("op", op),
+		slog.String("user", item.UserId.String()),
+		slog.String("name", item.Name),
+	)
+
+	log.Info("attempting to create identity item")
+
+	if err := i.prepare(ctx, &item); err != nil {
+		log.Info("failed to prepare identity item", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := i.identityItemSaver.CreateIdentityItem(ctx, item)
+	if err != nil {
+		log.Error("failed to create identity item", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (i *Service) GetIdentityItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.IdentityItem, error) {
+	const op = "IdentityItemService.GetIdentityItem"
+
+	log := i.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to get identity item")
+	item, err := i.identityItemProvider.GetIdentityItem(ctx, itemId, userId)
+	if err != nil {
+		log.Error("failed to get identity item", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := i.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := decrypt(cipher, item); err != nil {
+		log.Error("failed to decrypt identity item", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return item, nil
+}
+
+func (i *Service) GetIdentityItems(ctx context.Context, userId uuid.UUID) ([]*domain.IdentityItem, error) {
+	const op = "IdentityItemService.GetIdentityItems"
+
+	log := i.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to get identity items")
+	items, err := i.identityItemProvider.GetIdentityItems(ctx, userId)
+	if err != nil {
+		log.Error("failed to get identity items", sl.Err(err))
+
+		return make([]*domain.IdentityItem, 0), fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := i.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return make([]*domain.IdentityItem, 0), fmt.Errorf("%s: %w", op, err)
+	}
+	for _, item := range items {
+		if err := decrypt(cipher, item); err != nil {
+			log.Error("failed to decrypt identity item", sl.Err(err), slog.String("item", item.ID.String()))
+
+			return make([]*domain.IdentityItem, 0), fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return items, nil
+}
+
+func (i *Service) UpdateIdentityItem(ctx context.Context, item domain.IdentityItem) error {
+	const op = "IdentityItemService.UpdateIdentityItem"
+
+	log := i.log.With(
+		slog.String("op", op),
+		slog.String("user", item.UserId.String()),
+		slog.String("item", item.ID.String()),
+	)
+
+	log.Info("attempting to update identity item")
+
+	if err := i.prepare(ctx, &item); err != nil {
+		log.Info("failed to prepare identity item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := i.identityItemSaver.UpdateIdentityItem(ctx, item); err != nil {
+		log.Error("failed to update identity item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (i *Service) DeleteIdentityItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error {
+	const op = "IdentityItemService.DeleteIdentityItem"
+
+	log := i.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to delete identity item")
+	if err := i.identityItemProvider.DeleteIdentityItem(ctx, userId, itemId); err != nil {
+		log.Error("failed to delete identity item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// prepare validates identity, fills summary shown in item lists
+// and encrypts identity document numbers.
+func (i *Service) prepare(ctx context.Context, item *domain.IdentityItem) error {
+	if err := validate(item); err != nil {
+		return err
+	}
+
+	item.Type = domain.ItemTypeIdentity
+	item.Summary = summary(item)
+
+	cipher, err := i.keyProvider.UserCipher(ctx, item.UserId)
+	if err != nil {
+		return err
+	}
+	for _, field := range sensitiveFields(item) {
+		*field, err = cipher.EncryptString(*field, item.UserId[:])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validate(item *domain.IdentityItem) error {
+	if item.FirstName == "" && item.LastName == "" && item.Email == "" {
+		return ErrEmptyIdentity
+	}
+	if item.Email != "" {
+		if _, err := mail.ParseAddress(item.Email); err != nil {
+			return ErrInvalidEmail
+		}
+	}
+	for _, c := range item.Phone {
+		if !unicode.IsDigit(c) && !strings.ContainsRune("+-() ", c) {
+			return ErrInvalidPhone
+		}
+	}
+	return nil
+}
+
+// summary is the full name or, if it's empty, the email.
+func summary(item *domain.IdentityItem) string {
+	name := strings.Join(strings.Fields(item.FirstName+" "+item.LastName), " ")
+	if name != "" {
+		return name
+	}
+	return item.Email
+}
+
+func sensitiveFields(item *domain.IdentityItem) []*string {
+	return []*string{&item.PassportNumber, &item.NationalId, &item.LicenseNumber}
+}
+
+func decrypt(cipher *aes.Cipher, item *domain.IdentityItem) error {
+	var err error
+	for _, field := range sensitiveFields(item) {
+		*field, err = cipher.DecryptString(*field, item.UserId[:])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -7,28 +7,47 @@ import (
 	"github.com/s0vunia/password-manager/internal/domain"
 	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
 	"log/slog"
+	"time"
 )
 
 type IItemService interface {
 	GetItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.Item, error)
 	GetItems(ctx context.Context, userId uuid.UUID) ([]*domain.Item, error)
+	GetItemsByFolder(ctx context.Context, folderId, userId uuid.UUID, recursive bool) ([]*domain.Item, error)
+	UpdateItem(ctx context.Context, patch domain.ItemPatch) (int64, error)
+	ListTrash(ctx context.Context, userId uuid.UUID) ([]*domain.Item, error)
+	RestoreItem(ctx context.Context, itemId, userId uuid.UUID) (int64, error)
+	PurgeItem(ctx context.Context, itemId, userId uuid.UUID) error
+	PurgeExpired(ctx context.Context, retention time.Duration, batchSize int) (int64, error)
 }
 type Service struct {
 	log          *slog.Logger
+	itemSaver    Saver
 	itemProvider Provider
 }
 
+type Saver interface {
+	UpdateItem(ctx context.Context, patch domain.ItemPatch) (int64, error)
+	RestoreItem(ctx context.Context, itemId, userId uuid.UUID) (int64, error)
+	PurgeItem(ctx context.Context, itemId, userId uuid.UUID) error
+	PurgeExpired(ctx context.Context, retention time.Duration, limit int) (int64, error)
+}
+
 type Provider interface {
 	GetItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.Item, error)
 	GetItems(ctx context.Context, userId uuid.UUID) ([]*domain.Item, error)
+	GetItemsByFolder(ctx context.Context, folderId, userId uuid.UUID, recursive bool) ([]*domain.Item, error)
+	ListTrash(ctx context.Context, userId uuid.UUID) ([]*domain.Item, error)
 }
 
 func New(
 	log *slog.Logger,
+	saver Saver,
 	provider Provider,
 ) *Service {
 	return &Service{
 		log:          log,
+		itemSaver:    saver,
 		itemProvider: provider,
 	}
 }
@@ -69,3 +88,135 @@ func (s *Service) GetItems(ctx context.Context, userId uuid.UUID) ([]*domain.Ite
 	}
 	return items, nil
 }
+
+// UpdateItem applies partial update to the item and returns its new revision.
+func (s *Service) UpdateItem(ctx context.Context, patch domain.ItemPatch) (int64, error) {
+	const op = "itemService.UpdateItem"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", patch.UserId.String()),
+		slog.String("item", patch.ID.String()),
+		slog.Int64("revision", patch.Revision),
+	)
+
+	log.Info("attempting to update item")
+	revision, err := s.itemSaver.UpdateItem(ctx, patch)
+	if err != nil {
+		log.Error("failed to update item", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return revision, nil
+}
+
+func (s *Service) GetItemsByFolder(ctx context.Context, folderId, userId uuid.UUID, recursive bool) ([]*domain.Item, error) {
+	const op = "itemService.GetItemsByFolder"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("folder", folderId.String()),
+		slog.Bool("recursive", recursive),
+	)
+
+	log.Info("attempting to get items by folder")
+	items, err := s.itemProvider.GetItemsByFolder(ctx, folderId, userId, recursive)
+	if err != nil {
+		log.Error("failed to get items by folder", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+func (s *Service) ListTrash(ctx context.Context, userId uuid.UUID) ([]*domain.Item, error) {
+	const op = "itemService.ListTrash"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to list trash")
+	items, err := s.itemProvider.ListTrash(ctx, userId)
+	if err != nil {
+		log.Error("failed to list trash", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+// RestoreItem moves the item out of the trash and returns its new revision.
+func (s *Service) RestoreItem(ctx context.Context, itemId, userId uuid.UUID) (int64, error) {
+	const op = "itemService.RestoreItem"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to restore item")
+	revision, err := s.itemSaver.RestoreItem(ctx, itemId, userId)
+	if err != nil {
+		log.Error("failed to restore item", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return revision, nil
+}
+
+// PurgeItem permanently deletes the item from the trash.
+func (s *Service) PurgeItem(ctx context.Context, itemId, userId uuid.UUID) error {
+	const op = "itemService.PurgeItem"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to purge item")
+	if err := s.itemSaver.PurgeItem(ctx, itemId, userId); err != nil {
+		log.Error("failed to purge item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// PurgeExpired permanently deletes items which are in the trash longer than retention,
+// batchSize items per query, and returns the total number of purged items.
+func (s *Service) PurgeExpired(ctx context.Context, retention time.Duration, batchSize int) (int64, error) {
+	const op = "itemService.PurgeExpired"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.Duration("retention", retention),
+	)
+
+	var purged int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return purged, fmt.Errorf("%s: %w", op, err)
+		}
+
+		n, err := s.itemSaver.PurgeExpired(ctx, retention, batchSize)
+		if err != nil {
+			log.Error("failed to purge expired items", sl.Err(err))
+
+			return purged, fmt.Errorf("%s: %w", op, err)
+		}
+		purged += n
+		if n < int64(batchSize) {
+			break
+		}
+	}
+
+	if purged > 0 {
+		log.Info("expired items purged", slog.Int64("purged", purged))
+	}
+	return purged, nil
+}
@@ -2,30 +2,49 @@ package loginItem
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
 	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"github.com/s0vunia/password-manager/internal/lib/totp"
 	"log/slog"
+	"time"
+)
+
+var (
+	ErrNoTOTP        = errors.New("login item has no totp secret")
+	ErrZeroKnowledge = errors.New("vault is encrypted on the client and totp codes can't be generated")
 )
 
 type ILoginItemService interface {
 	CreateLoginItem(ctx context.Context, item domain.LoginItem) (uuid.UUID, error)
 	GetLoginItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.LoginItem, error)
 	GetLoginItems(ctx context.Context, userId uuid.UUID) ([]*domain.LoginItem, error)
+	UpdateLoginItem(ctx context.Context, patch domain.LoginItemPatch) (int64, error)
+	GetLoginItemHistory(ctx context.Context, itemId, userId uuid.UUID) ([]*domain.LoginItemHistory, error)
+	RestoreLoginItemPassword(ctx context.Context, userId, itemId, historyId uuid.UUID, revision int64) (int64, error)
 	DeleteLoginItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+	GetTOTPCode(ctx context.Context, itemId, userId uuid.UUID) (*domain.TOTPCode, error)
 }
 
 type Service struct {
 	log               *slog.Logger
 	loginItemSaver    Saver
 	loginItemProvider Provider
+	keyProvider       KeyProvider
+	userProvider      UserProvider
+	historySize       int
 }
 
 type Saver interface {
 	CreateLoginItem(ctx context.Context,
 		item domain.LoginItem,
 	) (uuid.UUID, error)
+	UpdateLoginItem(ctx context.Context, patch domain.LoginItemPatch) (int64, error)
+	PruneLoginItemHistory(ctx context.Context, loginItemId uuid.UUID, keep int) error
+	SealLegacyPassword(ctx context.Context, loginItemId uuid.UUID, legacy string, sealed string) error
 }
 
 type Provider interface {
@@ -35,17 +54,35 @@ type Provider interface {
 	GetLoginItems(ctx context.Context,
 		userId uuid.UUID) ([]*domain.LoginItem, error)
 	DeleteLoginItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+	GetLoginItemHistory(ctx context.Context, loginItemId, userId uuid.UUID) ([]*domain.LoginItemHistory, error)
+	GetLoginItemHistoryEntry(ctx context.Context, historyId, loginItemId, userId uuid.UUID) (*domain.LoginItemHistory, error)
+}
+
+// KeyProvider returns cipher keyed with the user's data encryption key.
+type KeyProvider interface {
+	UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error)
+}
+
+// UserProvider tells whether the user's vault is encrypted on the client.
+type UserProvider interface {
+	IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error)
 }
 
 func New(
 	log *slog.Logger,
 	saver Saver,
 	provider Provider,
+	keyProvider KeyProvider,
+	userProvider UserProvider,
+	historySize int,
 ) *Service {
 	return &Service{
 		log:               log,
 		loginItemSaver:    saver,
 		loginItemProvider: provider,
+		keyProvider:       keyProvider,
+		userProvider:      userProvider,
+		historySize:       historySize,
 	}
 }
 
@@ -54,11 +91,33 @@ func (l *Service) CreateLoginItem(ctx context.Context, item domain.LoginItem) (u
 
 	log := l.log.With(
 		slog.String("op", op),
-		slog.Any("item", item),
+		slog.String("user", item.UserId.String()),
+		slog.String("name", item.Name),
 	)
 
 	log.Info("attempting to create login item")
 
+	cipher, err := l.keyProvider.UserCipher(ctx, item.UserId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	item.EncryptPassword, err = cipher.EncryptString(item.EncryptPassword, item.UserId[:])
+	if err != nil {
+		log.Error("failed to encrypt password", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if item.EncryptTOTP != "" {
+		item.EncryptTOTP, err = l.sealTOTP(ctx, cipher, item.EncryptTOTP, item.UserId)
+		if err != nil {
+			log.Info("failed to seal totp secret", sl.Err(err))
+
+			return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	id, err := l.loginItemSaver.CreateLoginItem(ctx, item)
 	if err != nil {
 		log.Error("failed to create login item", sl.Err(err))
@@ -84,6 +143,27 @@ func (l *Service) GetLoginItem(ctx context.Context, itemId, userId uuid.UUID) (*
 
 		return &domain.LoginItem{}, fmt.Errorf("%s: %w", op, err)
 	}
+
+	cipher, err := l.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return &domain.LoginItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+	item.EncryptPassword, err = l.openPassword(ctx, log, cipher, item, userId)
+	if err != nil {
+		log.Error("failed to decrypt password", sl.Err(err))
+
+		return &domain.LoginItem{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if item.EncryptTOTP != "" {
+		item.EncryptTOTP, err = cipher.DecryptString(item.EncryptTOTP, userId[:])
+		if err != nil {
+			log.Error("failed to decrypt totp secret", sl.Err(err))
+
+			return &domain.LoginItem{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
 	return item, nil
 
 }
@@ -103,8 +183,172 @@ func (l *Service) GetLoginItems(ctx context.Context, userId uuid.UUID) ([]*domai
 		log.Error("failed to get login itemS", sl.Err(err))
 		return make([]*domain.LoginItem, 0), fmt.Errorf("%s: %w", op, err)
 	}
-	return items, nil
+
+	cipher, err := l.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return make([]*domain.LoginItem, 0), fmt.Errorf("%s: %w", op, err)
+	}
+	// An item which can't be decrypted is left out, so it doesn't hide the rest of the vault,
+	// GetLoginItem still reports its error.
+	opened := make([]*domain.LoginItem, 0, len(items))
+	for _, item := range items {
+		item.EncryptPassword, err = l.openPassword(ctx, log, cipher, item, userId)
+		if err != nil {
+			log.Error("failed to decrypt password", sl.Err(err), slog.String("item", item.ID.String()))
+
+			continue
+		}
+		if item.EncryptTOTP != "" {
+			item.EncryptTOTP, err = cipher.DecryptString(item.EncryptTOTP, userId[:])
+			if err != nil {
+				log.Error("failed to decrypt totp secret", sl.Err(err), slog.String("item", item.ID.String()))
+
+				continue
+			}
+		}
+		opened = append(opened, item)
+	}
+	return opened, nil
 }
+
+// UpdateLoginItem applies partial update to the login item, encrypting
+// the new password and totp secret if they're changed, and returns the new item revision.
+func (l *Service) UpdateLoginItem(ctx context.Context, patch domain.LoginItemPatch) (int64, error) {
+	const op = "LoginItemService.UpdateLoginItem"
+
+	log := l.log.With(
+		slog.String("op", op),
+		slog.String("user", patch.UserId.String()),
+		slog.String("item", patch.ID.String()),
+		slog.Int64("revision", patch.Revision),
+	)
+
+	log.Info("attempting to update login item")
+
+	if patch.EncryptPassword != nil || patch.EncryptTOTP != nil {
+		cipher, err := l.keyProvider.UserCipher(ctx, patch.UserId)
+		if err != nil {
+			log.Error("failed to get user key", sl.Err(err))
+
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		if patch.EncryptPassword != nil {
+			encrypted, err := cipher.EncryptString(*patch.EncryptPassword, patch.UserId[:])
+			if err != nil {
+				log.Error("failed to encrypt password", sl.Err(err))
+
+				return 0, fmt.Errorf("%s: %w", op, err)
+			}
+			patch.EncryptPassword = &encrypted
+		}
+		if patch.EncryptTOTP != nil && *patch.EncryptTOTP != "" {
+			sealed, err := l.sealTOTP(ctx, cipher, *patch.EncryptTOTP, patch.UserId)
+			if err != nil {
+				log.Info("failed to seal totp secret", sl.Err(err))
+
+				return 0, fmt.Errorf("%s: %w", op, err)
+			}
+			patch.EncryptTOTP = &sealed
+		}
+	}
+
+	revision, err := l.loginItemSaver.UpdateLoginItem(ctx, patch)
+	if err != nil {
+		log.Error("failed to update login item", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if patch.EncryptPassword != nil {
+		// History over the limit is cleaned up on the next password change if this fails.
+		if err := l.loginItemSaver.PruneLoginItemHistory(ctx, patch.ID, l.historySize); err != nil {
+			log.Warn("failed to prune password history", sl.Err(err))
+		}
+	}
+	return revision, nil
+}
+
+// GetLoginItemHistory returns previous credentials of the login item, newest first.
+func (l *Service) GetLoginItemHistory(ctx context.Context, itemId, userId uuid.UUID) ([]*domain.LoginItemHistory, error) {
+	const op = "LoginItemService.GetLoginItemHistory"
+
+	log := l.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to get login item history")
+	entries, err := l.loginItemProvider.GetLoginItemHistory(ctx, itemId, userId)
+	if err != nil {
+		log.Error("failed to get login item history", sl.Err(err))
+
+		return make([]*domain.LoginItemHistory, 0), fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := l.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return make([]*domain.LoginItemHistory, 0), fmt.Errorf("%s: %w", op, err)
+	}
+	for _, entry := range entries {
+		entry.EncryptPassword, _, err = openLegacy(cipher, entry.EncryptPassword, userId)
+		if err != nil {
+			log.Error("failed to decrypt password", sl.Err(err), slog.String("history", entry.ID.String()))
+
+			return make([]*domain.LoginItemHistory, 0), fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return entries, nil
+}
+
+// RestoreLoginItemPassword sets the password from the history entry as the current one.
+// It's a regular update, so the replaced password is kept in history as well.
+func (l *Service) RestoreLoginItemPassword(ctx context.Context, userId, itemId, historyId uuid.UUID, revision int64) (int64, error) {
+	const op = "LoginItemService.RestoreLoginItemPassword"
+
+	log := l.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+		slog.String("history", historyId.String()),
+	)
+
+	log.Info("attempting to restore login item password")
+	entry, err := l.loginItemProvider.GetLoginItemHistoryEntry(ctx, historyId, itemId, userId)
+	if err != nil {
+		log.Error("failed to get history entry", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := l.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	password, _, err := openLegacy(cipher, entry.EncryptPassword, userId)
+	if err != nil {
+		log.Error("failed to decrypt password", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	revision, err = l.UpdateLoginItem(ctx, domain.LoginItemPatch{
+		ItemPatch:       domain.ItemPatch{UserId: userId, Revision: revision},
+		ID:              itemId,
+		EncryptPassword: &password,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return revision, nil
+}
+
 func (l *Service) DeleteLoginItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error {
 	const op = "LoginItemService.DeleteLoginItem"
 
@@ -121,3 +365,79 @@ func (l *Service) DeleteLoginItem(ctx context.Context, userId uuid.UUID, itemId
 	}
 	return nil
 }
+
+// GetTOTPCode returns the current code of the login item's second factor.
+// Secrets of zero-knowledge users are encrypted on the client, so their codes are generated there.
+func (l *Service) GetTOTPCode(ctx context.Context, itemId, userId uuid.UUID) (*domain.TOTPCode, error) {
+	const op = "LoginItemService.GetTOTPCode"
+
+	zk, err := l.userProvider.IsZeroKnowledge(ctx, userId)
+	if err != nil {
+		l.log.Error("failed to get user", slog.String("op", op), slog.String("user", userId.String()), sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if zk {
+		return nil, fmt.Errorf("%s: %w", op, ErrZeroKnowledge)
+	}
+
+	item, err := l.GetLoginItem(ctx, itemId, userId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if item.EncryptTOTP == "" {
+		return nil, fmt.Errorf("%s: %w", op, ErrNoTOTP)
+	}
+	key, err := totp.Parse(item.EncryptTOTP)
+	if err != nil {
+		l.log.Error("failed to parse stored totp secret", slog.String("op", op), slog.String("item", itemId.String()), sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	code, remaining := key.Code(time.Now())
+	return &domain.TOTPCode{Code: code, Remaining: remaining, Period: key.Period}, nil
+}
+
+// openPassword decrypts the password of the item. Passwords stored before they were encrypted
+// on the server are sealed with the user's key on first read, failing to save one doesn't fail the read.
+func (l *Service) openPassword(ctx context.Context, log *slog.Logger, cipher *aes.Cipher, item *domain.LoginItem, userId uuid.UUID) (string, error) {
+	password, legacy, err := openLegacy(cipher, item.EncryptPassword, userId)
+	if err != nil || !legacy {
+		return password, err
+	}
+	sealed, err := cipher.EncryptString(password, userId[:])
+	if err != nil {
+		return "", err
+	}
+	if err := l.loginItemSaver.SealLegacyPassword(ctx, item.ID, item.EncryptPassword, sealed); err != nil {
+		log.Warn("failed to seal legacy password", sl.Err(err), slog.String("item", item.ID.String()))
+	}
+	return password, nil
+}
+
+// openLegacy decrypts the envelope. Values which aren't envelopes were stored as clients sent them
+// before envelope encryption, so they're returned as is and reported as legacy.
+func openLegacy(cipher *aes.Cipher, value string, userId uuid.UUID) (string, bool, error) {
+	plaintext, err := cipher.DecryptString(value, userId[:])
+	if errors.Is(err, aes.ErrMalformed) || errors.Is(err, aes.ErrUnsupportedVersion) {
+		return value, true, nil
+	}
+	return plaintext, false, err
+}
+
+// sealTOTP validates the otpauth URI and encrypts it in the normalized form.
+// Zero-knowledge users send the URI encrypted on the client, it's sealed as is.
+func (l *Service) sealTOTP(ctx context.Context, cipher *aes.Cipher, uri string, userId uuid.UUID) (string, error) {
+	zk, err := l.userProvider.IsZeroKnowledge(ctx, userId)
+	if err != nil {
+		return "", err
+	}
+	if !zk {
+		key, err := totp.Parse(uri)
+		if err != nil {
+			return "", err
+		}
+		uri = key.URI()
+	}
+	return cipher.EncryptString(uri, userId[:])
+}
@@ -0,0 +1,236 @@
+package loginItem
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/totp"
+	"github.com/s0vunia/password-manager/internal/repositories"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+type legacySaver struct {
+	Saver
+	sealed map[uuid.UUID]string
+}
+
+func (s *legacySaver) SealLegacyPassword(ctx context.Context, loginItemId uuid.UUID, legacy string, sealed string) error {
+	s.sealed[loginItemId] = sealed
+	return nil
+}
+
+func TestOpenPassword(t *testing.T) {
+	cipher, err := aes.New(make([]byte, aes.KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	userId := uuid.New()
+	envelope, err := cipher.EncryptString("s3cret", userId[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherUser := uuid.New()
+	foreign, err := cipher.EncryptString("s3cret", otherUser[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		stored   string
+		want     string
+		wantSeal bool
+		wantErr  error
+	}{
+		{name: "envelope", stored: envelope, want: "s3cret"},
+		{name: "legacy plaintext", stored: "hunter2", want: "hunter2", wantSeal: true},
+		{name: "legacy hex", stored: "6c6b616a73666b6c", want: "6c6b616a73666b6c", wantSeal: true},
+		{name: "empty", stored: "", want: "", wantSeal: true},
+		{name: "envelope of another user", stored: foreign, wantErr: aes.ErrDecrypt},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saver := &legacySaver{sealed: map[uuid.UUID]string{}}
+			s := New(slog.New(slog.NewTextHandler(io.Discard, nil)), saver, nil, nil, nil, 0)
+			item := &domain.LoginItem{ID: uuid.New(), EncryptPassword: tt.stored}
+
+			got, err := s.openPassword(context.Background(), s.log, cipher, item, userId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("openPassword() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("openPassword() = %q, want %q", got, tt.want)
+			}
+			sealed, ok := saver.sealed[item.ID]
+			if ok != tt.wantSeal {
+				t.Fatalf("sealed = %v, want %v", ok, tt.wantSeal)
+			}
+			if ok {
+				if opened, err := cipher.DecryptString(sealed, userId[:]); err != nil || opened != tt.want {
+					t.Errorf("sealed password opens to %q, %v", opened, err)
+				}
+			}
+		})
+	}
+}
+
+// vault serves login items of their owners only, like the repository does.
+type vault struct {
+	Provider
+	items map[uuid.UUID]*domain.LoginItem
+}
+
+func (v vault) GetLoginItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.LoginItem, error) {
+	item, ok := v.items[itemId]
+	if !ok || item.UserId != userId {
+		return nil, repositories.ErrItemNotFound
+	}
+	copied := *item
+	return &copied, nil
+}
+
+func (v vault) GetLoginItems(ctx context.Context, userId uuid.UUID) ([]*domain.LoginItem, error) {
+	var items []*domain.LoginItem
+	for _, item := range v.items {
+		if item.UserId == userId {
+			copied := *item
+			items = append(items, &copied)
+		}
+	}
+	return items, nil
+}
+
+// userKeys records the users whose keys were requested.
+type userKeys struct {
+	cipher    *aes.Cipher
+	requested []uuid.UUID
+}
+
+func (k *userKeys) UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error) {
+	k.requested = append(k.requested, userId)
+	return k.cipher, nil
+}
+
+func TestGetLoginItemOpensWithKeyOfRequestedUser(t *testing.T) {
+	cipher, err := aes.New(make([]byte, aes.KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner, otherUser := uuid.New(), uuid.New()
+	password, err := cipher.EncryptString("s3cret", owner[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := &domain.LoginItem{
+		Item:            domain.Item{UserId: owner},
+		ID:              uuid.New(),
+		EncryptPassword: password,
+	}
+	provider := vault{items: map[uuid.UUID]*domain.LoginItem{stored.ID: stored}}
+
+	tests := []struct {
+		name    string
+		userId  uuid.UUID
+		want    string
+		wantErr error
+	}{
+		{name: "owner", userId: owner, want: "s3cret"},
+		{name: "other user", userId: otherUser, wantErr: repositories.ErrItemNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := &userKeys{cipher: cipher}
+			s := New(slog.New(slog.NewTextHandler(io.Discard, nil)), nil, provider, keys, nil, 0)
+
+			item, err := s.GetLoginItem(context.Background(), stored.ID, tt.userId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetLoginItem() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(keys.requested) != 0 {
+					t.Errorf("key of %v was requested for an item the user doesn't own", keys.requested)
+				}
+				return
+			}
+			if item.EncryptPassword != tt.want {
+				t.Errorf("password = %q, want %q", item.EncryptPassword, tt.want)
+			}
+
+			items, err := s.GetLoginItems(context.Background(), tt.userId)
+			if err != nil || len(items) != 1 {
+				t.Fatalf("GetLoginItems() = %v, %v", items, err)
+			}
+			for _, requested := range keys.requested {
+				if requested != tt.userId {
+					t.Errorf("key of %v was requested, want %v", requested, tt.userId)
+				}
+			}
+		})
+	}
+}
+
+type zeroKnowledgeUsers map[uuid.UUID]bool
+
+func (z zeroKnowledgeUsers) IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error) {
+	return z[userId], nil
+}
+
+func TestTOTPOfZeroKnowledgeUser(t *testing.T) {
+	cipher, err := aes.New(make([]byte, aes.KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, zkUser := uuid.New(), uuid.New()
+	const uri = "otpauth://totp/Example:alice?secret=JBSWY3DPEHPK3PXP&issuer=Example"
+
+	tests := []struct {
+		name        string
+		userId      uuid.UUID
+		totp        string
+		wantSealErr error
+		wantCodeErr error
+	}{
+		{name: "otpauth uri", userId: user, totp: uri},
+		{name: "invalid uri", userId: user, totp: "client-encrypted secret", wantSealErr: totp.ErrInvalidURI},
+		{name: "zero-knowledge user", userId: zkUser, totp: "client-encrypted secret", wantCodeErr: ErrZeroKnowledge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil, zeroKnowledgeUsers{zkUser: true}, 0)
+
+			sealed, err := s.sealTOTP(context.Background(), cipher, tt.totp, tt.userId)
+			if !errors.Is(err, tt.wantSealErr) {
+				t.Fatalf("sealTOTP() error = %v, want %v", err, tt.wantSealErr)
+			}
+			if err != nil {
+				return
+			}
+			opened, err := cipher.DecryptString(sealed, tt.userId[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.userId == zkUser && opened != tt.totp {
+				t.Errorf("sealed secret opens to %q, want %q", opened, tt.totp)
+			}
+
+			item := &domain.LoginItem{Item: domain.Item{UserId: tt.userId}, ID: uuid.New(), EncryptTOTP: sealed}
+			if item.EncryptPassword, err = cipher.EncryptString("s3cret", tt.userId[:]); err != nil {
+				t.Fatal(err)
+			}
+			s.loginItemProvider = vault{items: map[uuid.UUID]*domain.LoginItem{item.ID: item}}
+			s.keyProvider = &userKeys{cipher: cipher}
+
+			code, err := s.GetTOTPCode(context.Background(), item.ID, tt.userId)
+			if !errors.Is(err, tt.wantCodeErr) {
+				t.Fatalf("GetTOTPCode() error = %v, want %v", err, tt.wantCodeErr)
+			}
+			if err == nil && len(code.Code) != 6 {
+				t.Errorf("code = %q", code.Code)
+			}
+		})
+	}
+}
@@ -2,14 +2,199 @@ package noteItem
 
 import (
 	"context"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/s0vunia/password-manager/internal/domain"
-	"github.com/s0vunia/password-manager/internal/services/manager/item"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"log/slog"
 )
 
 type INoteItemService interface {
-	item.IItemService
-	CreateNoteItem(ctx context.Context, item *domain.NoteItem, userId uuid.UUID) (*domain.NoteItem, error)
+	CreateNoteItem(ctx context.Context, item domain.NoteItem) (uuid.UUID, error)
 	GetNoteItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.NoteItem, error)
 	GetNoteItems(ctx context.Context, userId uuid.UUID) ([]*domain.NoteItem, error)
+	UpdateNoteItem(ctx context.Context, item domain.NoteItem) error
+	DeleteNoteItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
+
+type Service struct {
+	log              *slog.Logger
+	noteItemSaver    Saver
+	noteItemProvider Provider
+	keyProvider      KeyProvider
+}
+
+type Saver interface {
+	CreateNoteItem(ctx context.Context, item domain.NoteItem) (uuid.UUID, error)
+	UpdateNoteItem(ctx context.Context, item domain.NoteItem) error
+}
+
+type Provider interface {
+	GetNoteItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.NoteItem, error)
+	GetNoteItems(ctx context.Context, userId uuid.UUID) ([]*domain.NoteItem, error)
+	DeleteNoteItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
+
+// KeyProvider returns cipher keyed with the user's data encryption key.
+type KeyProvider interface {
+	UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error)
+}
+
+func New(
+	log *slog.Logger,
+	saver Saver,
+	provider Provider,
+	keyProvider KeyProvider,
+) *Service {
+	return &Service{
+		log:              log,
+		noteItemSaver:    saver,
+		noteItemProvider: provider,
+		keyProvider:      keyProvider,
+	}
+}
+
+func (n *Service) CreateNoteItem(ctx context.Context, item domain.NoteItem) (uuid.UUID, error) {
+	const op = "NoteItemService.CreateNoteItem"
+
+	log := n.log.With(
+		slog.String("op", op),
+		slog.String("user", item.UserId.String()),
+		slog.String("name", item.Name),
+	)
+
+	log.Info("attempting to create note item")
+
+	item.Type = domain.ItemTypeNote
+	if err := n.encrypt(ctx, &item); err != nil {
+		log.Error("failed to encrypt note", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := n.noteItemSaver.CreateNoteItem(ctx, item)
+	if err != nil {
+		log.Error("failed to create note item", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (n *Service) GetNoteItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.NoteItem, error) {
+	const op = "NoteItemService.GetNoteItem"
+
+	log := n.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to get note item")
+	item, err := n.noteItemProvider.GetNoteItem(ctx, itemId, userId)
+	if err != nil {
+		log.Error("failed to get note item", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := n.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	item.Note, err = cipher.DecryptString(item.Note, userId[:])
+	if err != nil {
+		log.Error("failed to decrypt note", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return item, nil
+}
+
+func (n *Service) GetNoteItems(ctx context.Context, userId uuid.UUID) ([]*domain.NoteItem, error) {
+	const op = "NoteItemService.GetNoteItems"
+
+	log := n.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to get note items")
+	items, err := n.noteItemProvider.GetNoteItems(ctx, userId)
+	if err != nil {
+		log.Error("failed to get note items", sl.Err(err))
+
+		return make([]*domain.NoteItem, 0), fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := n.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return make([]*domain.NoteItem, 0), fmt.Errorf("%s: %w", op, err)
+	}
+	for _, item := range items {
+		item.Note, err = cipher.DecryptString(item.Note, userId[:])
+		if err != nil {
+			log.Error("failed to decrypt note", sl.Err(err), slog.String("item", item.ID.String()))
+
+			return make([]*domain.NoteItem, 0), fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return items, nil
+}
+
+func (n *Service) UpdateNoteItem(ctx context.Context, item domain.NoteItem) error {
+	const op = "NoteItemService.UpdateNoteItem"
+
+	log := n.log.With(
+		slog.String("op", op),
+		slog.String("user", item.UserId.String()),
+		slog.String("item", item.ID.String()),
+	)
+
+	log.Info("attempting to update note item")
+
+	if err := n.encrypt(ctx, &item); err != nil {
+		log.Error("failed to encrypt note", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := n.noteItemSaver.UpdateNoteItem(ctx, item); err != nil {
+		log.Error("failed to update note item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (n *Service) DeleteNoteItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error {
+	const op = "NoteItemService.DeleteNoteItem"
+
+	log := n.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to delete note item")
+	if err := n.noteItemProvider.DeleteNoteItem(ctx, userId, itemId); err != nil {
+		log.Error("failed to delete note item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (n *Service) encrypt(ctx context.Context, item *domain.NoteItem) error {
+	cipher, err := n.keyProvider.UserCipher(ctx, item.UserId)
+	if err != nil {
+		return err
+	}
+	item.Note, err = cipher.EncryptString(item.Note, item.UserId[:])
+	return err
 }
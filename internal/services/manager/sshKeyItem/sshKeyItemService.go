@@ -0,0 +1,256 @@
+package sshKeyItem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"github.com/s0vunia/password-manager/internal/lib/sshkey"
+	"log/slog"
+	"strings"
+)
+
+var (
+	ErrPublicKeyRequired = errors.New("public key is required when the private key is encrypted on the client")
+)
+
+type ISSHKeyItemService interface {
+	CreateSSHKeyItem(ctx context.Context, item domain.SSHKeyItem) (uuid.UUID, error)
+	GetSSHKeyItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.SSHKeyItem, error)
+	GetSSHKeyItems(ctx context.Context, userId uuid.UUID) ([]*domain.SSHKeyItem, error)
+	UpdateSSHKeyItem(ctx context.Context, item domain.SSHKeyItem) error
+	DeleteSSHKeyItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
+
+type Service struct {
+	log                *slog.Logger
+	sshKeyItemSaver    Saver
+	sshKeyItemProvider Provider
+	keyProvider        KeyProvider
+	userProvider       UserProvider
+}
+
+type Saver interface {
+	CreateSSHKeyItem(ctx context.Context, item domain.SSHKeyItem) (uuid.UUID, error)
+	UpdateSSHKeyItem(ctx context.Context, item domain.SSHKeyItem) error
+}
+
+type Provider interface {
+	GetSSHKeyItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.SSHKeyItem, error)
+	GetSSHKeyItems(ctx context.Context, userId uuid.UUID) ([]*domain.SSHKeyItem, error)
+	DeleteSSHKeyItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error
+}
+
+// KeyProvider returns cipher keyed with the user's data encryption key.
+type KeyProvider interface {
+	UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error)
+}
+
+// UserProvider tells whether the user's vault is encrypted on the client.
+type UserProvider interface {
+	IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error)
+}
+
+func New(
+	log *slog.Logger,
+	saver Saver,
+	provider Provider,
+	keyProvider KeyProvider,
+	userProvider UserProvider,
+) *Service {
+	return &Service{
+		log:                log,
+		sshKeyItemSaver:    saver,
+		sshKeyItemProvider: provider,
+		keyProvider:        keyProvider,
+		userProvider:       userProvider,
+	}
+}
+
+func (s *Service) CreateSSHKeyItem(ctx context.Context, item domain.SSHKeyItem) (uuid.UUID, error) {
+	const op = "SSHKeyItemService.CreateSSHKeyItem"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", item.UserId.String()),
+		slog.String("name", item.Name),
+	)
+
+	log.Info("attempting to create SSH key item")
+
+	if err := s.prepare(ctx, &item); err != nil {
+		log.Info("failed to prepare SSH key item", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := s.sshKeyItemSaver.CreateSSHKeyItem(ctx, item)
+	if err != nil {
+		log.Error("failed to create SSH key item", sl.Err(err))
+
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (s *Service) GetSSHKeyItem(ctx context.Context, itemId, userId uuid.UUID) (*domain.SSHKeyItem, error) {
+	const op = "SSHKeyItemService.GetSSHKeyItem"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to get SSH key item")
+	item, err := s.sshKeyItemProvider.GetSSHKeyItem(ctx, itemId, userId)
+	if err != nil {
+		log.Error("failed to get SSH key item", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := s.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := decrypt(cipher, item); err != nil {
+		log.Error("failed to decrypt SSH key item", sl.Err(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return item, nil
+}
+
+func (s *Service) GetSSHKeyItems(ctx context.Context, userId uuid.UUID) ([]*domain.SSHKeyItem, error) {
+	const op = "SSHKeyItemService.GetSSHKeyItems"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	log.Info("attempting to get SSH key items")
+	items, err := s.sshKeyItemProvider.GetSSHKeyItems(ctx, userId)
+	if err != nil {
+		log.Error("failed to get SSH key items", sl.Err(err))
+
+		return make([]*domain.SSHKeyItem, 0), fmt.Errorf("%s: %w", op, err)
+	}
+
+	cipher, err := s.keyProvider.UserCipher(ctx, userId)
+	if err != nil {
+		log.Error("failed to get user key", sl.Err(err))
+
+		return make([]*domain.SSHKeyItem, 0), fmt.Errorf("%s: %w", op, err)
+	}
+	for _, item := range items {
+		if err := decrypt(cipher, item); err != nil {
+			log.Error("failed to decrypt SSH key item", sl.Err(err), slog.String("item", item.ID.String()))
+
+			return make([]*domain.SSHKeyItem, 0), fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return items, nil
+}
+
+func (s *Service) UpdateSSHKeyItem(ctx context.Context, item domain.SSHKeyItem) error {
+	const op = "SSHKeyItemService.UpdateSSHKeyItem"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", item.UserId.String()),
+		slog.String("item", item.ID.String()),
+	)
+
+	log.Info("attempting to update SSH key item")
+
+	if err := s.prepare(ctx, &item); err != nil {
+		log.Info("failed to prepare SSH key item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.sshKeyItemSaver.UpdateSSHKeyItem(ctx, item); err != nil {
+		log.Error("failed to update SSH key item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *Service) DeleteSSHKeyItem(ctx context.Context, userId uuid.UUID, itemId uuid.UUID) error {
+	const op = "SSHKeyItemService.DeleteSSHKeyItem"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("item", itemId.String()),
+	)
+
+	log.Info("attempting to delete SSH key item")
+	if err := s.sshKeyItemProvider.DeleteSSHKeyItem(ctx, userId, itemId); err != nil {
+		log.Error("failed to delete SSH key item", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// prepare validates the key pair, derives type, public key and fingerprint
+// from the private key and encrypts private key and passphrase.
+// Private keys of zero-knowledge users are encrypted on the client, so type
+// and fingerprint are derived from the public key the client has to send.
+func (s *Service) prepare(ctx context.Context, item *domain.SSHKeyItem) error {
+	zk, err := s.userProvider.IsZeroKnowledge(ctx, item.UserId)
+	if err != nil {
+		return err
+	}
+
+	var key *sshkey.Key
+	if zk {
+		if strings.TrimSpace(item.PublicKey) == "" {
+			return ErrPublicKeyRequired
+		}
+		key, err = sshkey.ParsePublicKey(item.PublicKey)
+	} else {
+		key, err = sshkey.Parse(item.PrivateKey, item.Passphrase, item.PublicKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	item.Type = domain.ItemTypeSSHKey
+	item.KeyType = key.Type
+	item.PublicKey = key.PublicKey
+	item.Fingerprint = key.Fingerprint
+	item.Summary = key.Fingerprint
+
+	cipher, err := s.keyProvider.UserCipher(ctx, item.UserId)
+	if err != nil {
+		return err
+	}
+	for _, field := range []*string{&item.PrivateKey, &item.Passphrase} {
+		*field, err = cipher.EncryptString(*field, item.UserId[:])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decrypt(cipher *aes.Cipher, item *domain.SSHKeyItem) error {
+	var err error
+	for _, field := range []*string{&item.PrivateKey, &item.Passphrase} {
+		*field, err = cipher.DecryptString(*field, item.UserId[:])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package sshKeyItem
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/sshkey"
+	"golang.org/x/crypto/ssh"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+type staticKey struct {
+	cipher *aes.Cipher
+}
+
+func (k staticKey) UserCipher(ctx context.Context, userId uuid.UUID) (*aes.Cipher, error) {
+	return k.cipher, nil
+}
+
+type zeroKnowledgeUsers map[uuid.UUID]bool
+
+func (z zeroKnowledgeUsers) IsZeroKnowledge(ctx context.Context, userId uuid.UUID) (bool, error) {
+	return z[userId], nil
+}
+
+func TestPrepare(t *testing.T) {
+	cipher, err := aes.New(make([]byte, aes.KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := string(pem.EncodeToMemory(block))
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPub)))
+	fingerprint := ssh.FingerprintSHA256(sshPub)
+
+	user, zkUser := uuid.New(), uuid.New()
+	s := New(slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, staticKey{cipher}, zeroKnowledgeUsers{zkUser: true})
+
+	tests := []struct {
+		name    string
+		item    domain.SSHKeyItem
+		wantErr error
+	}{
+		{name: "private key", item: domain.SSHKeyItem{Item: domain.Item{UserId: user}, PrivateKey: privateKey}},
+		{name: "invalid private key", item: domain.SSHKeyItem{Item: domain.Item{UserId: user}, PrivateKey: "client-encrypted key"},
+			wantErr: sshkey.ErrInvalidPrivateKey},
+		{name: "zero-knowledge user", item: domain.SSHKeyItem{Item: domain.Item{UserId: zkUser},
+			PrivateKey: "client-encrypted key", PublicKey: publicKey}},
+		{name: "zero-knowledge user without public key", item: domain.SSHKeyItem{Item: domain.Item{UserId: zkUser},
+			PrivateKey: "client-encrypted key"}, wantErr: ErrPublicKeyRequired},
+		{name: "zero-knowledge user with invalid public key", item: domain.SSHKeyItem{Item: domain.Item{UserId: zkUser},
+			PrivateKey: "client-encrypted key", PublicKey: "ssh-ed25519 garbage"}, wantErr: sshkey.ErrInvalidPublicKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := tt.item
+			err := s.prepare(context.Background(), &item)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("prepare() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if item.KeyType != ssh.KeyAlgoED25519 || item.PublicKey != publicKey || item.Fingerprint != fingerprint {
+				t.Errorf("key = %q %q %q, want %q %q %q", item.KeyType, item.PublicKey, item.Fingerprint,
+					ssh.KeyAlgoED25519, publicKey, fingerprint)
+			}
+			if item.Summary != fingerprint {
+				t.Errorf("Summary = %q, want %q", item.Summary, fingerprint)
+			}
+			if err := decrypt(cipher, &item); err != nil {
+				t.Fatalf("decrypt() error = %v", err)
+			}
+			if item.PrivateKey != tt.item.PrivateKey {
+				t.Errorf("PrivateKey = %q, want %q", item.PrivateKey, tt.item.PrivateKey)
+			}
+		})
+	}
+}
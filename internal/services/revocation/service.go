@@ -0,0 +1,214 @@
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// IRevocationService rejects access tokens before they expire. Tokens are revoked by their
+// id (jti), by the session they belong to, or all tokens of a user issued up to a moment.
+// Revocations are kept in memory and synced from the store, so checking a token doesn't
+// query the database; revocations made on other instances are seen after syncInterval.
+type IRevocationService interface {
+	RevokeToken(ctx context.Context, userId uuid.UUID, id uuid.UUID) error
+	RevokeAll(ctx context.Context, userId uuid.UUID) error
+	IsRevoked(ctx context.Context, userId uuid.UUID, ids []uuid.UUID, issuedAt time.Time) (bool, error)
+}
+
+type Service struct {
+	log          *slog.Logger
+	store        Store
+	tokenTTL     time.Duration
+	syncInterval time.Duration
+
+	mu       sync.RWMutex
+	tokens   map[uuid.UUID]time.Time
+	users    map[uuid.UUID]time.Time
+	syncedAt time.Time
+	syncing  bool
+}
+
+type Store interface {
+	RevokeToken(ctx context.Context, id uuid.UUID, userId uuid.UUID, expiresAt time.Time) error
+	RevokeUserTokens(ctx context.Context, userId uuid.UUID, before time.Time) error
+	RevokedTokens(ctx context.Context, now time.Time) (map[uuid.UUID]time.Time, error)
+	UserRevocations(ctx context.Context, since time.Time) (map[uuid.UUID]time.Time, error)
+}
+
+func New(
+	log *slog.Logger,
+	store Store,
+	tokenTTL time.Duration,
+	syncInterval time.Duration,
+) *Service {
+	return &Service{
+		log:          log,
+		store:        store,
+		tokenTTL:     tokenTTL,
+		syncInterval: syncInterval,
+		tokens:       make(map[uuid.UUID]time.Time),
+		users:        make(map[uuid.UUID]time.Time),
+	}
+}
+
+// RevokeToken revokes the access token or all access tokens of the session with the id.
+// It's kept until every token issued by now has expired.
+func (s *Service) RevokeToken(ctx context.Context, userId uuid.UUID, id uuid.UUID) error {
+	const op = "RevocationService.RevokeToken"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+		slog.String("id", id.String()),
+	)
+
+	expiresAt := time.Now().Add(s.tokenTTL)
+	if err := s.store.RevokeToken(ctx, id, userId, expiresAt); err != nil {
+		log.Error("failed to revoke token", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.mu.Lock()
+	s.tokens[id] = maxTime(s.tokens[id], expiresAt)
+	s.mu.Unlock()
+
+	log.Info("token revoked")
+
+	return nil
+}
+
+// RevokeAll revokes every access token of the user issued until now.
+func (s *Service) RevokeAll(ctx context.Context, userId uuid.UUID) error {
+	const op = "RevocationService.RevokeAll"
+
+	log := s.log.With(
+		slog.String("op", op),
+		slog.String("user", userId.String()),
+	)
+
+	before := time.Now()
+	if err := s.store.RevokeUserTokens(ctx, userId, before); err != nil {
+		log.Error("failed to revoke user tokens", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.mu.Lock()
+	s.users[userId] = maxTime(s.users[userId], before)
+	s.mu.Unlock()
+
+	log.Info("all user tokens revoked")
+
+	return nil
+}
+
+// IsRevoked reports whether a token of the user issued at issuedAt is revoked by any of ids,
+// which are its id and the id of its session, zero ids are ignored.
+// It returns an error only if revocations were never loaded, later sync failures keep the cached ones.
+func (s *Service) IsRevoked(ctx context.Context, userId uuid.UUID, ids []uuid.UUID, issuedAt time.Time) (bool, error) {
+	const op = "RevocationService.IsRevoked"
+
+	if err := s.ensureSynced(ctx); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, id := range ids {
+		if id == uuid.Nil {
+			continue
+		}
+		if expiresAt, ok := s.tokens[id]; ok && expiresAt.After(now) {
+			return true, nil
+		}
+	}
+	if before, ok := s.users[userId]; ok && !issuedAt.After(before) {
+		return true, nil
+	}
+	return false, nil
+}
+
+// ensureSynced loads revocations on first use and refreshes them in the background once they're stale.
+func (s *Service) ensureSynced(ctx context.Context) error {
+	s.mu.RLock()
+	synced := !s.syncedAt.IsZero()
+	fresh := s.syncing || time.Since(s.syncedAt) <= s.syncInterval
+	s.mu.RUnlock()
+	if !synced {
+		return s.sync(ctx)
+	}
+	if fresh {
+		return nil
+	}
+
+	s.mu.Lock()
+	if s.syncing {
+		s.mu.Unlock()
+		return nil
+	}
+	s.syncing = true
+	s.mu.Unlock()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), s.syncInterval)
+		defer cancel()
+
+		if err := s.sync(ctx); err != nil {
+			s.log.Error("failed to sync token revocations", sl.Err(err))
+		}
+		s.mu.Lock()
+		s.syncing = false
+		s.mu.Unlock()
+	}()
+	return nil
+}
+
+// sync merges revocations from the store and evicts the ones which can't match
+// an unexpired token anymore. Revocations are never undone, so merging is safe
+// with ones made locally meanwhile.
+func (s *Service) sync(ctx context.Context) error {
+	now := time.Now()
+	tokens, err := s.store.RevokedTokens(ctx, now)
+	if err != nil {
+		return err
+	}
+	users, err := s.store.UserRevocations(ctx, now.Add(-s.tokenTTL))
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, expiresAt := range tokens {
+		s.tokens[id] = maxTime(s.tokens[id], expiresAt)
+	}
+	for id, expiresAt := range s.tokens {
+		if !expiresAt.After(now) {
+			delete(s.tokens, id)
+		}
+	}
+	for userId, before := range users {
+		s.users[userId] = maxTime(s.users[userId], before)
+	}
+	for userId, before := range s.users {
+		if before.Add(s.tokenTTL).Before(now) {
+			delete(s.users, userId)
+		}
+	}
+	s.syncedAt = now
+	return nil
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
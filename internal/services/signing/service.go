@@ -0,0 +1,347 @@
+package signing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/s0vunia/password-manager/internal/domain"
+	"github.com/s0vunia/password-manager/internal/lib/aes"
+	"github.com/s0vunia/password-manager/internal/lib/jwt"
+	"github.com/s0vunia/password-manager/internal/lib/logger/sl"
+	"github.com/s0vunia/password-manager/internal/repositories/signingKey"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// minReloadInterval limits reloads of the ring caused by tokens with unknown kid.
+const minReloadInterval = 5 * time.Second
+
+var (
+	ErrNoSigningKey = errors.New("no active signing key")
+)
+
+// ISigningService keeps the ring of asymmetric keys access tokens are signed with.
+// A new key is published in JWKS PublishAhead before it starts signing, so verifiers
+// which cache JWKS know it in time, and replaced keys stay published until tokens
+// they signed have expired.
+type ISigningService interface {
+	SigningKey(ctx context.Context) (*jwt.SigningKey, error)
+	VerificationKey(ctx context.Context, kid string) (*jwt.SigningKey, error)
+	JWKS(ctx context.Context) (jwt.JWKS, error)
+	Rotate(ctx context.Context) error
+	ResealKeys(ctx context.Context) (int, error)
+}
+
+type Options struct {
+	Algorithm string
+	// RotationInterval is how long a key signs tokens before the next one replaces it.
+	RotationInterval time.Duration
+	PublishAhead     time.Duration
+	// RefreshInterval is how soon keys rotated by another instance are loaded.
+	RefreshInterval time.Duration
+	TokenTTL        time.Duration
+}
+
+type Service struct {
+	log    *slog.Logger
+	master *aes.Keyring
+	store  Store
+	opts   Options
+
+	mu       sync.RWMutex
+	keys     []ringKey
+	loadedAt time.Time
+}
+
+// ringKey is a loaded key, keys of the ring are ordered by activation.
+type ringKey struct {
+	key         *jwt.SigningKey
+	activatesAt time.Time
+	retiresAt   *time.Time
+}
+
+type Store interface {
+	List(ctx context.Context, now time.Time) ([]domain.SigningKey, error)
+	Rotate(ctx context.Context, key domain.SigningKey, rotateAfter time.Time, retireAt time.Time) (bool, error)
+	DeleteRetired(ctx context.Context, now time.Time) (int64, error)
+	ResealKeys(ctx context.Context, targetVersion int, reseal signingKey.ResealFunc) (int, error)
+}
+
+func New(
+	log *slog.Logger,
+	master *aes.Keyring,
+	store Store,
+	opts Options,
+) *Service {
+	return &Service{
+		log:    log,
+		master: master,
+		store:  store,
+		opts:   opts,
+	}
+}
+
+// SigningKey returns the key new tokens are signed with, it's the latest activated one.
+// The first key is created on demand if the ring is empty.
+func (s *Service) SigningKey(ctx context.Context) (*jwt.SigningKey, error) {
+	const op = "SigningService.SigningKey"
+
+	keys, err := s.ring(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if key := activeKey(keys, time.Now()); key != nil {
+		return key, nil
+	}
+
+	if err := s.Rotate(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s.mu.RLock()
+	key := activeKey(s.keys, time.Now())
+	s.mu.RUnlock()
+	if key == nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrNoSigningKey)
+	}
+	return key, nil
+}
+
+// VerificationKey returns the published key with the kid. Unknown kids reload the ring,
+// as the key could have been created by another instance.
+func (s *Service) VerificationKey(ctx context.Context, kid string) (*jwt.SigningKey, error) {
+	const op = "SigningService.VerificationKey"
+
+	keys, err := s.ring(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if key := publishedKey(keys, kid, time.Now()); key != nil {
+		return key, nil
+	}
+
+	s.mu.RLock()
+	recent := time.Since(s.loadedAt) < minReloadInterval
+	s.mu.RUnlock()
+	if recent {
+		return nil, fmt.Errorf("%s: %w", op, jwt.ErrUnknownKey)
+	}
+	if err := s.load(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s.mu.RLock()
+	key := publishedKey(s.keys, kid, time.Now())
+	s.mu.RUnlock()
+	if key == nil {
+		return nil, fmt.Errorf("%s: %w", op, jwt.ErrUnknownKey)
+	}
+	return key, nil
+}
+
+// JWKS returns public keys of the ring which aren't retired, including the next key.
+func (s *Service) JWKS(ctx context.Context) (jwt.JWKS, error) {
+	const op = "SigningService.JWKS"
+
+	keys, err := s.ring(ctx)
+	if err != nil {
+		return jwt.JWKS{}, fmt.Errorf("%s: %w", op, err)
+	}
+	now := time.Now()
+	jwks := jwt.JWKS{Keys: []jwt.JWK{}}
+	for _, key := range keys {
+		if key.retiresAt == nil || key.retiresAt.After(now) {
+			jwks.Keys = append(jwks.Keys, key.key.JWK())
+		}
+	}
+	return jwks, nil
+}
+
+// Rotate creates the next key once the latest one has signed tokens for RotationInterval
+// less PublishAhead, or once the configured algorithm changed, and deletes retired keys.
+// The first key of an empty ring is active right away.
+func (s *Service) Rotate(ctx context.Context) error {
+	const op = "SigningService.Rotate"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	now := time.Now()
+	keys, err := s.store.List(ctx, now)
+	if err != nil {
+		log.Error("failed to list signing keys", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	activatesAt := now.Add(s.opts.PublishAhead)
+	rotateAfter := now.Add(s.opts.PublishAhead - s.opts.RotationInterval)
+	due := true
+	if len(keys) == 0 {
+		activatesAt = now
+	} else if latest := keys[len(keys)-1]; latest.Algorithm != s.opts.Algorithm {
+		rotateAfter = latest.ActivatesAt
+	} else {
+		due = !latest.ActivatesAt.After(rotateAfter)
+	}
+
+	if due {
+		kid, added, err := s.addKey(ctx, now, activatesAt, rotateAfter)
+		if err != nil {
+			log.Error("failed to add signing key", sl.Err(err))
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if added {
+			log.Info("signing key rotated", slog.String("kid", kid), slog.Time("activates_at", activatesAt))
+		}
+	}
+
+	deleted, err := s.store.DeleteRetired(ctx, now)
+	if err != nil {
+		log.Error("failed to delete retired signing keys", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if deleted > 0 {
+		log.Info("retired signing keys deleted", slog.Int64("count", deleted))
+	}
+
+	if err := s.load(ctx); err != nil {
+		log.Error("failed to load signing keys", sl.Err(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ResealKeys seals private keys with the current master key version,
+// so previous master keys can be dropped.
+func (s *Service) ResealKeys(ctx context.Context) (int, error) {
+	const op = "SigningService.ResealKeys"
+
+	log := s.log.With(
+		slog.String("op", op),
+	)
+
+	version, current := s.master.Current()
+	resealed, err := s.store.ResealKeys(ctx, version, func(key domain.SigningKey) (string, error) {
+		master, err := s.master.Cipher(key.KeyVersion)
+		if err != nil {
+			return "", err
+		}
+		private, err := master.Decrypt(key.PrivateKey, []byte(key.ID))
+		if err != nil {
+			return "", err
+		}
+		return current.Encrypt(private, []byte(key.ID))
+	})
+	if err != nil {
+		log.Error("failed to reseal signing keys", sl.Err(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return resealed, nil
+}
+
+// addKey generates a key activating at activatesAt and stores it sealed with the master key,
+// keys it replaces retire once tokens they signed have expired.
+func (s *Service) addKey(ctx context.Context, now time.Time, activatesAt time.Time, rotateAfter time.Time) (string, bool, error) {
+	key, err := jwt.GenerateKey(s.opts.Algorithm)
+	if err != nil {
+		return "", false, err
+	}
+	private, err := key.MarshalPrivate()
+	if err != nil {
+		return "", false, err
+	}
+	version, master := s.master.Current()
+	sealed, err := master.Encrypt(private, []byte(key.ID))
+	if err != nil {
+		return "", false, err
+	}
+
+	added, err := s.store.Rotate(ctx, domain.SigningKey{
+		ID:          key.ID,
+		Algorithm:   key.Algorithm,
+		PrivateKey:  sealed,
+		KeyVersion:  version,
+		CreatedAt:   now,
+		ActivatesAt: activatesAt,
+	}, rotateAfter, activatesAt.Add(s.opts.TokenTTL))
+	if err != nil {
+		return "", false, err
+	}
+	return key.ID, added, nil
+}
+
+// ring returns loaded keys, reloading them once they're older than RefreshInterval.
+// A failed reload keeps the loaded keys.
+func (s *Service) ring(ctx context.Context) ([]ringKey, error) {
+	s.mu.RLock()
+	keys, loadedAt := s.keys, s.loadedAt
+	s.mu.RUnlock()
+	if !loadedAt.IsZero() && time.Since(loadedAt) <= s.opts.RefreshInterval {
+		return keys, nil
+	}
+
+	if err := s.load(ctx); err != nil {
+		if loadedAt.IsZero() {
+			return nil, err
+		}
+		s.log.Error("failed to reload signing keys", sl.Err(err))
+
+		return keys, nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.keys, nil
+}
+
+func (s *Service) load(ctx context.Context) error {
+	now := time.Now()
+	stored, err := s.store.List(ctx, now)
+	if err != nil {
+		return err
+	}
+	keys := make([]ringKey, 0, len(stored))
+	for _, key := range stored {
+		master, err := s.master.Cipher(key.KeyVersion)
+		if err != nil {
+			return fmt.Errorf("signing key %s: %w", key.ID, err)
+		}
+		private, err := master.Decrypt(key.PrivateKey, []byte(key.ID))
+		if err != nil {
+			return fmt.Errorf("signing key %s: %w", key.ID, err)
+		}
+		signingKey, err := jwt.ParsePrivateKey(key.Algorithm, private)
+		if err != nil {
+			return fmt.Errorf("signing key %s: %w", key.ID, err)
+		}
+		keys = append(keys, ringKey{key: signingKey, activatesAt: key.ActivatesAt, retiresAt: key.RetiresAt})
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.loadedAt = now
+	s.mu.Unlock()
+	return nil
+}
+
+func activeKey(keys []ringKey, now time.Time) *jwt.SigningKey {
+	for i := len(keys) - 1; i >= 0; i-- {
+		if !keys[i].activatesAt.After(now) {
+			return keys[i].key
+		}
+	}
+	return nil
+}
+
+func publishedKey(keys []ringKey, kid string, now time.Time) *jwt.SigningKey {
+	for _, key := range keys {
+		if key.key.ID == kid && (key.retiresAt == nil || key.retiresAt.After(now)) {
+			return key.key
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1833 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.26.1
+// source: manager/manager.proto
+
+package manager
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ManagerClient is the client API for Manager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ManagerClient interface {
+	CreateLoginItem(ctx context.Context, in *CreateLoginItemRequest, opts ...grpc.CallOption) (*CreateLoginItemResponse, error)
+	GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*GetItemResponse, error)
+	GetItems(ctx context.Context, in *GetItemsRequest, opts ...grpc.CallOption) (*GetItemsResponse, error)
+	GetLoginItem(ctx context.Context, in *GetLoginItemRequest, opts ...grpc.CallOption) (*GetLoginItemResponse, error)
+	GetLoginItems(ctx context.Context, in *GetLoginItemsRequest, opts ...grpc.CallOption) (*GetLoginItemsResponse, error)
+	GetItemsByFolder(ctx context.Context, in *GetItemsByFolderRequest, opts ...grpc.CallOption) (*GetItemsByFolderResponse, error)
+	DeleteLoginItem(ctx context.Context, in *DeleteLoginItemRequest, opts ...grpc.CallOption) (*DeleteLoginItemResponse, error)
+	UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreItem(ctx context.Context, in *RestoreItemRequest, opts ...grpc.CallOption) (*RestoreItemResponse, error)
+	PurgeItem(ctx context.Context, in *PurgeItemRequest, opts ...grpc.CallOption) (*PurgeItemResponse, error)
+	UpdateLoginItem(ctx context.Context, in *UpdateLoginItemRequest, opts ...grpc.CallOption) (*UpdateLoginItemResponse, error)
+	GetLoginItemHistory(ctx context.Context, in *GetLoginItemHistoryRequest, opts ...grpc.CallOption) (*GetLoginItemHistoryResponse, error)
+	RestoreLoginItemPassword(ctx context.Context, in *RestoreLoginItemPasswordRequest, opts ...grpc.CallOption) (*RestoreLoginItemPasswordResponse, error)
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error)
+	GetFolders(ctx context.Context, in *GetFoldersRequest, opts ...grpc.CallOption) (*GetFoldersResponse, error)
+	GetFolderByPath(ctx context.Context, in *GetFolderByPathRequest, opts ...grpc.CallOption) (*GetFolderByPathResponse, error)
+	RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*RenameFolderResponse, error)
+	MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error)
+	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error)
+	CreateNoteItem(ctx context.Context, in *CreateNoteItemRequest, opts ...grpc.CallOption) (*CreateNoteItemResponse, error)
+	GetNoteItem(ctx context.Context, in *GetNoteItemRequest, opts ...grpc.CallOption) (*GetNoteItemResponse, error)
+	GetNoteItems(ctx context.Context, in *GetNoteItemsRequest, opts ...grpc.CallOption) (*GetNoteItemsResponse, error)
+	UpdateNoteItem(ctx context.Context, in *UpdateNoteItemRequest, opts ...grpc.CallOption) (*UpdateNoteItemResponse, error)
+	DeleteNoteItem(ctx context.Context, in *DeleteNoteItemRequest, opts ...grpc.CallOption) (*DeleteNoteItemResponse, error)
+	CreateCardItem(ctx context.Context, in *CreateCardItemRequest, opts ...grpc.CallOption) (*CreateCardItemResponse, error)
+	GetCardItem(ctx context.Context, in *GetCardItemRequest, opts ...grpc.CallOption) (*GetCardItemResponse, error)
+	GetCardItems(ctx context.Context, in *GetCardItemsRequest, opts ...grpc.CallOption) (*GetCardItemsResponse, error)
+	UpdateCardItem(ctx context.Context, in *UpdateCardItemRequest, opts ...grpc.CallOption) (*UpdateCardItemResponse, error)
+	DeleteCardItem(ctx context.Context, in *DeleteCardItemRequest, opts ...grpc.CallOption) (*DeleteCardItemResponse, error)
+	CreateIdentityItem(ctx context.Context, in *CreateIdentityItemRequest, opts ...grpc.CallOption) (*CreateIdentityItemResponse, error)
+	GetIdentityItem(ctx context.Context, in *GetIdentityItemRequest, opts ...grpc.CallOption) (*GetIdentityItemResponse, error)
+	GetIdentityItems(ctx context.Context, in *GetIdentityItemsRequest, opts ...grpc.CallOption) (*GetIdentityItemsResponse, error)
+	UpdateIdentityItem(ctx context.Context, in *UpdateIdentityItemRequest, opts ...grpc.CallOption) (*UpdateIdentityItemResponse, error)
+	DeleteIdentityItem(ctx context.Context, in *DeleteIdentityItemRequest, opts ...grpc.CallOption) (*DeleteIdentityItemResponse, error)
+	CreateSSHKeyItem(ctx context.Context, in *CreateSSHKeyItemRequest, opts ...grpc.CallOption) (*CreateSSHKeyItemResponse, error)
+	GetSSHKeyItem(ctx context.Context, in *GetSSHKeyItemRequest, opts ...grpc.CallOption) (*GetSSHKeyItemResponse, error)
+	GetSSHKeyItems(ctx context.Context, in *GetSSHKeyItemsRequest, opts ...grpc.CallOption) (*GetSSHKeyItemsResponse, error)
+	UpdateSSHKeyItem(ctx context.Context, in *UpdateSSHKeyItemRequest, opts ...grpc.CallOption) (*UpdateSSHKeyItemResponse, error)
+	DeleteSSHKeyItem(ctx context.Context, in *DeleteSSHKeyItemRequest, opts ...grpc.CallOption) (*DeleteSSHKeyItemResponse, error)
+	CreateAPIKeyItem(ctx context.Context, in *CreateAPIKeyItemRequest, opts ...grpc.CallOption) (*CreateAPIKeyItemResponse, error)
+	GetAPIKeyItem(ctx context.Context, in *GetAPIKeyItemRequest, opts ...grpc.CallOption) (*GetAPIKeyItemResponse, error)
+	GetAPIKeyItems(ctx context.Context, in *GetAPIKeyItemsRequest, opts ...grpc.CallOption) (*GetAPIKeyItemsResponse, error)
+	UpdateAPIKeyItem(ctx context.Context, in *UpdateAPIKeyItemRequest, opts ...grpc.CallOption) (*UpdateAPIKeyItemResponse, error)
+	DeleteAPIKeyItem(ctx context.Context, in *DeleteAPIKeyItemRequest, opts ...grpc.CallOption) (*DeleteAPIKeyItemResponse, error)
+	GeneratePassword(ctx context.Context, in *GeneratePasswordRequest, opts ...grpc.CallOption) (*GeneratePasswordResponse, error)
+	GetVaultHealth(ctx context.Context, in *GetVaultHealthRequest, opts ...grpc.CallOption) (*GetVaultHealthResponse, error)
+	CheckBreaches(ctx context.Context, in *CheckBreachesRequest, opts ...grpc.CallOption) (*CheckBreachesResponse, error)
+	GetTOTPCode(ctx context.Context, in *GetTOTPCodeRequest, opts ...grpc.CallOption) (*GetTOTPCodeResponse, error)
+}
+
+type managerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewManagerClient(cc grpc.ClientConnInterface) ManagerClient {
+	return &managerClient{cc}
+}
+
+func (c *managerClient) CreateLoginItem(ctx context.Context, in *CreateLoginItemRequest, opts ...grpc.CallOption) (*CreateLoginItemResponse, error) {
+	out := new(CreateLoginItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/CreateLoginItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*GetItemResponse, error) {
+	out := new(GetItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetItems(ctx context.Context, in *GetItemsRequest, opts ...grpc.CallOption) (*GetItemsResponse, error) {
+	out := new(GetItemsResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetLoginItem(ctx context.Context, in *GetLoginItemRequest, opts ...grpc.CallOption) (*GetLoginItemResponse, error) {
+	out := new(GetLoginItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetLoginItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetLoginItems(ctx context.Context, in *GetLoginItemsRequest, opts ...grpc.CallOption) (*GetLoginItemsResponse, error) {
+	out := new(GetLoginItemsResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetLoginItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetItemsByFolder(ctx context.Context, in *GetItemsByFolderRequest, opts ...grpc.CallOption) (*GetItemsByFolderResponse, error) {
+	out := new(GetItemsByFolderResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetItemsByFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) DeleteLoginItem(ctx context.Context, in *DeleteLoginItemRequest, opts ...grpc.CallOption) (*DeleteLoginItemResponse, error) {
+	out := new(DeleteLoginItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/DeleteLoginItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error) {
+	out := new(UpdateItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/UpdateItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/ListTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) RestoreItem(ctx context.Context, in *RestoreItemRequest, opts ...grpc.CallOption) (*RestoreItemResponse, error) {
+	out := new(RestoreItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/RestoreItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) PurgeItem(ctx context.Context, in *PurgeItemRequest, opts ...grpc.CallOption) (*PurgeItemResponse, error) {
+	out := new(PurgeItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/PurgeItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) UpdateLoginItem(ctx context.Context, in *UpdateLoginItemRequest, opts ...grpc.CallOption) (*UpdateLoginItemResponse, error) {
+	out := new(UpdateLoginItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/UpdateLoginItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetLoginItemHistory(ctx context.Context, in *GetLoginItemHistoryRequest, opts ...grpc.CallOption) (*GetLoginItemHistoryResponse, error) {
+	out := new(GetLoginItemHistoryResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetLoginItemHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) RestoreLoginItemPassword(ctx context.Context, in *RestoreLoginItemPasswordRequest, opts ...grpc.CallOption) (*RestoreLoginItemPasswordResponse, error) {
+	out := new(RestoreLoginItemPasswordResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/RestoreLoginItemPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error) {
+	out := new(CreateFolderResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/CreateFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetFolders(ctx context.Context, in *GetFoldersRequest, opts ...grpc.CallOption) (*GetFoldersResponse, error) {
+	out := new(GetFoldersResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetFolders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetFolderByPath(ctx context.Context, in *GetFolderByPathRequest, opts ...grpc.CallOption) (*GetFolderByPathResponse, error) {
+	out := new(GetFolderByPathResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetFolderByPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*RenameFolderResponse, error) {
+	out := new(RenameFolderResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/RenameFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) MoveFolder(ctx context.Context, in *MoveFolderRequest, opts ...grpc.CallOption) (*MoveFolderResponse, error) {
+	out := new(MoveFolderResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/MoveFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error) {
+	out := new(DeleteFolderResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/DeleteFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) CreateNoteItem(ctx context.Context, in *CreateNoteItemRequest, opts ...grpc.CallOption) (*CreateNoteItemResponse, error) {
+	out := new(CreateNoteItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/CreateNoteItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetNoteItem(ctx context.Context, in *GetNoteItemRequest, opts ...grpc.CallOption) (*GetNoteItemResponse, error) {
+	out := new(GetNoteItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetNoteItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetNoteItems(ctx context.Context, in *GetNoteItemsRequest, opts ...grpc.CallOption) (*GetNoteItemsResponse, error) {
+	out := new(GetNoteItemsResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetNoteItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) UpdateNoteItem(ctx context.Context, in *UpdateNoteItemRequest, opts ...grpc.CallOption) (*UpdateNoteItemResponse, error) {
+	out := new(UpdateNoteItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/UpdateNoteItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) DeleteNoteItem(ctx context.Context, in *DeleteNoteItemRequest, opts ...grpc.CallOption) (*DeleteNoteItemResponse, error) {
+	out := new(DeleteNoteItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/DeleteNoteItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) CreateCardItem(ctx context.Context, in *CreateCardItemRequest, opts ...grpc.CallOption) (*CreateCardItemResponse, error) {
+	out := new(CreateCardItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/CreateCardItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetCardItem(ctx context.Context, in *GetCardItemRequest, opts ...grpc.CallOption) (*GetCardItemResponse, error) {
+	out := new(GetCardItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetCardItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetCardItems(ctx context.Context, in *GetCardItemsRequest, opts ...grpc.CallOption) (*GetCardItemsResponse, error) {
+	out := new(GetCardItemsResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetCardItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) UpdateCardItem(ctx context.Context, in *UpdateCardItemRequest, opts ...grpc.CallOption) (*UpdateCardItemResponse, error) {
+	out := new(UpdateCardItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/UpdateCardItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) DeleteCardItem(ctx context.Context, in *DeleteCardItemRequest, opts ...grpc.CallOption) (*DeleteCardItemResponse, error) {
+	out := new(DeleteCardItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/DeleteCardItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) CreateIdentityItem(ctx context.Context, in *CreateIdentityItemRequest, opts ...grpc.CallOption) (*CreateIdentityItemResponse, error) {
+	out := new(CreateIdentityItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/CreateIdentityItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetIdentityItem(ctx context.Context, in *GetIdentityItemRequest, opts ...grpc.CallOption) (*GetIdentityItemResponse, error) {
+	out := new(GetIdentityItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetIdentityItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetIdentityItems(ctx context.Context, in *GetIdentityItemsRequest, opts ...grpc.CallOption) (*GetIdentityItemsResponse, error) {
+	out := new(GetIdentityItemsResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetIdentityItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) UpdateIdentityItem(ctx context.Context, in *UpdateIdentityItemRequest, opts ...grpc.CallOption) (*UpdateIdentityItemResponse, error) {
+	out := new(UpdateIdentityItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/UpdateIdentityItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) DeleteIdentityItem(ctx context.Context, in *DeleteIdentityItemRequest, opts ...grpc.CallOption) (*DeleteIdentityItemResponse, error) {
+	out := new(DeleteIdentityItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/DeleteIdentityItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) CreateSSHKeyItem(ctx context.Context, in *CreateSSHKeyItemRequest, opts ...grpc.CallOption) (*CreateSSHKeyItemResponse, error) {
+	out := new(CreateSSHKeyItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/CreateSSHKeyItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetSSHKeyItem(ctx context.Context, in *GetSSHKeyItemRequest, opts ...grpc.CallOption) (*GetSSHKeyItemResponse, error) {
+	out := new(GetSSHKeyItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetSSHKeyItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetSSHKeyItems(ctx context.Context, in *GetSSHKeyItemsRequest, opts ...grpc.CallOption) (*GetSSHKeyItemsResponse, error) {
+	out := new(GetSSHKeyItemsResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetSSHKeyItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) UpdateSSHKeyItem(ctx context.Context, in *UpdateSSHKeyItemRequest, opts ...grpc.CallOption) (*UpdateSSHKeyItemResponse, error) {
+	out := new(UpdateSSHKeyItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/UpdateSSHKeyItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) DeleteSSHKeyItem(ctx context.Context, in *DeleteSSHKeyItemRequest, opts ...grpc.CallOption) (*DeleteSSHKeyItemResponse, error) {
+	out := new(DeleteSSHKeyItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/DeleteSSHKeyItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) CreateAPIKeyItem(ctx context.Context, in *CreateAPIKeyItemRequest, opts ...grpc.CallOption) (*CreateAPIKeyItemResponse, error) {
+	out := new(CreateAPIKeyItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/CreateAPIKeyItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetAPIKeyItem(ctx context.Context, in *GetAPIKeyItemRequest, opts ...grpc.CallOption) (*GetAPIKeyItemResponse, error) {
+	out := new(GetAPIKeyItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetAPIKeyItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetAPIKeyItems(ctx context.Context, in *GetAPIKeyItemsRequest, opts ...grpc.CallOption) (*GetAPIKeyItemsResponse, error) {
+	out := new(GetAPIKeyItemsResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetAPIKeyItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) UpdateAPIKeyItem(ctx context.Context, in *UpdateAPIKeyItemRequest, opts ...grpc.CallOption) (*UpdateAPIKeyItemResponse, error) {
+	out := new(UpdateAPIKeyItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/UpdateAPIKeyItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) DeleteAPIKeyItem(ctx context.Context, in *DeleteAPIKeyItemRequest, opts ...grpc.CallOption) (*DeleteAPIKeyItemResponse, error) {
+	out := new(DeleteAPIKeyItemResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/DeleteAPIKeyItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GeneratePassword(ctx context.Context, in *GeneratePasswordRequest, opts ...grpc.CallOption) (*GeneratePasswordResponse, error) {
+	out := new(GeneratePasswordResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GeneratePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetVaultHealth(ctx context.Context, in *GetVaultHealthRequest, opts ...grpc.CallOption) (*GetVaultHealthResponse, error) {
+	out := new(GetVaultHealthResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetVaultHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) CheckBreaches(ctx context.Context, in *CheckBreachesRequest, opts ...grpc.CallOption) (*CheckBreachesResponse, error) {
+	out := new(CheckBreachesResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/CheckBreaches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetTOTPCode(ctx context.Context, in *GetTOTPCodeRequest, opts ...grpc.CallOption) (*GetTOTPCodeResponse, error) {
+	out := new(GetTOTPCodeResponse)
+	err := c.cc.Invoke(ctx, "/manager.Manager/GetTOTPCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagerServer is the server API for Manager service.
+// All implementations must embed UnimplementedManagerServer
+// for forward compatibility
+type ManagerServer interface {
+	CreateLoginItem(context.Context, *CreateLoginItemRequest) (*CreateLoginItemResponse, error)
+	GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error)
+	GetItems(context.Context, *GetItemsRequest) (*GetItemsResponse, error)
+	GetLoginItem(context.Context, *GetLoginItemRequest) (*GetLoginItemResponse, error)
+	GetLoginItems(context.Context, *GetLoginItemsRequest) (*GetLoginItemsResponse, error)
+	GetItemsByFolder(context.Context, *GetItemsByFolderRequest) (*GetItemsByFolderResponse, error)
+	DeleteLoginItem(context.Context, *DeleteLoginItemRequest) (*DeleteLoginItemResponse, error)
+	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreItem(context.Context, *RestoreItemRequest) (*RestoreItemResponse, error)
+	PurgeItem(context.Context, *PurgeItemRequest) (*PurgeItemResponse, error)
+	UpdateLoginItem(context.Context, *UpdateLoginItemRequest) (*UpdateLoginItemResponse, error)
+	GetLoginItemHistory(context.Context, *GetLoginItemHistoryRequest) (*GetLoginItemHistoryResponse, error)
+	RestoreLoginItemPassword(context.Context, *RestoreLoginItemPasswordRequest) (*RestoreLoginItemPasswordResponse, error)
+	CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error)
+	GetFolders(context.Context, *GetFoldersRequest) (*GetFoldersResponse, error)
+	GetFolderByPath(context.Context, *GetFolderByPathRequest) (*GetFolderByPathResponse, error)
+	RenameFolder(context.Context, *RenameFolderRequest) (*RenameFolderResponse, error)
+	MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error)
+	DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error)
+	CreateNoteItem(context.Context, *CreateNoteItemRequest) (*CreateNoteItemResponse, error)
+	GetNoteItem(context.Context, *GetNoteItemRequest) (*GetNoteItemResponse, error)
+	GetNoteItems(context.Context, *GetNoteItemsRequest) (*GetNoteItemsResponse, error)
+	UpdateNoteItem(context.Context, *UpdateNoteItemRequest) (*UpdateNoteItemResponse, error)
+	DeleteNoteItem(context.Context, *DeleteNoteItemRequest) (*DeleteNoteItemResponse, error)
+	CreateCardItem(context.Context, *CreateCardItemRequest) (*CreateCardItemResponse, error)
+	GetCardItem(context.Context, *GetCardItemRequest) (*GetCardItemResponse, error)
+	GetCardItems(context.Context, *GetCardItemsRequest) (*GetCardItemsResponse, error)
+	UpdateCardItem(context.Context, *UpdateCardItemRequest) (*UpdateCardItemResponse, error)
+	DeleteCardItem(context.Context, *DeleteCardItemRequest) (*DeleteCardItemResponse, error)
+	CreateIdentityItem(context.Context, *CreateIdentityItemRequest) (*CreateIdentityItemResponse, error)
+	GetIdentityItem(context.Context, *GetIdentityItemRequest) (*GetIdentityItemResponse, error)
+	GetIdentityItems(context.Context, *GetIdentityItemsRequest) (*GetIdentityItemsResponse, error)
+	UpdateIdentityItem(context.Context, *UpdateIdentityItemRequest) (*UpdateIdentityItemResponse, error)
+	DeleteIdentityItem(context.Context, *DeleteIdentityItemRequest) (*DeleteIdentityItemResponse, error)
+	CreateSSHKeyItem(context.Context, *CreateSSHKeyItemRequest) (*CreateSSHKeyItemResponse, error)
+	GetSSHKeyItem(context.Context, *GetSSHKeyItemRequest) (*GetSSHKeyItemResponse, error)
+	GetSSHKeyItems(context.Context, *GetSSHKeyItemsRequest) (*GetSSHKeyItemsResponse, error)
+	UpdateSSHKeyItem(context.Context, *UpdateSSHKeyItemRequest) (*UpdateSSHKeyItemResponse, error)
+	DeleteSSHKeyItem(context.Context, *DeleteSSHKeyItemRequest) (*DeleteSSHKeyItemResponse, error)
+	CreateAPIKeyItem(context.Context, *CreateAPIKeyItemRequest) (*CreateAPIKeyItemResponse, error)
+	GetAPIKeyItem(context.Context, *GetAPIKeyItemRequest) (*GetAPIKeyItemResponse, error)
+	GetAPIKeyItems(context.Context, *GetAPIKeyItemsRequest) (*GetAPIKeyItemsResponse, error)
+	UpdateAPIKeyItem(context.Context, *UpdateAPIKeyItemRequest) (*UpdateAPIKeyItemResponse, error)
+	DeleteAPIKeyItem(context.Context, *DeleteAPIKeyItemRequest) (*DeleteAPIKeyItemResponse, error)
+	GeneratePassword(context.Context, *GeneratePasswordRequest) (*GeneratePasswordResponse, error)
+	GetVaultHealth(context.Context, *GetVaultHealthRequest) (*GetVaultHealthResponse, error)
+	CheckBreaches(context.Context, *CheckBreachesRequest) (*CheckBreachesResponse, error)
+	GetTOTPCode(context.Context, *GetTOTPCodeRequest) (*GetTOTPCodeResponse, error)
+	mustEmbedUnimplementedManagerServer()
+}
+
+// UnimplementedManagerServer must be embedded to have forward compatible implementations.
+type UnimplementedManagerServer struct {
+}
+
+func (UnimplementedManagerServer) CreateLoginItem(context.Context, *CreateLoginItemRequest) (*CreateLoginItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLoginItem not implemented")
+}
+func (UnimplementedManagerServer) GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
+}
+func (UnimplementedManagerServer) GetItems(context.Context, *GetItemsRequest) (*GetItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItems not implemented")
+}
+func (UnimplementedManagerServer) GetLoginItem(context.Context, *GetLoginItemRequest) (*GetLoginItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoginItem not implemented")
+}
+func (UnimplementedManagerServer) GetLoginItems(context.Context, *GetLoginItemsRequest) (*GetLoginItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoginItems not implemented")
+}
+func (UnimplementedManagerServer) GetItemsByFolder(context.Context, *GetItemsByFolderRequest) (*GetItemsByFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemsByFolder not implemented")
+}
+func (UnimplementedManagerServer) DeleteLoginItem(context.Context, *DeleteLoginItemRequest) (*DeleteLoginItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLoginItem not implemented")
+}
+func (UnimplementedManagerServer) UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+}
+func (UnimplementedManagerServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedManagerServer) RestoreItem(context.Context, *RestoreItemRequest) (*RestoreItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreItem not implemented")
+}
+func (UnimplementedManagerServer) PurgeItem(context.Context, *PurgeItemRequest) (*PurgeItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeItem not implemented")
+}
+func (UnimplementedManagerServer) UpdateLoginItem(context.Context, *UpdateLoginItemRequest) (*UpdateLoginItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLoginItem not implemented")
+}
+func (UnimplementedManagerServer) GetLoginItemHistory(context.Context, *GetLoginItemHistoryRequest) (*GetLoginItemHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoginItemHistory not implemented")
+}
+func (UnimplementedManagerServer) RestoreLoginItemPassword(context.Context, *RestoreLoginItemPasswordRequest) (*RestoreLoginItemPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreLoginItemPassword not implemented")
+}
+func (UnimplementedManagerServer) CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedManagerServer) GetFolders(context.Context, *GetFoldersRequest) (*GetFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFolders not implemented")
+}
+func (UnimplementedManagerServer) GetFolderByPath(context.Context, *GetFolderByPathRequest) (*GetFolderByPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFolderByPath not implemented")
+}
+func (UnimplementedManagerServer) RenameFolder(context.Context, *RenameFolderRequest) (*RenameFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFolder not implemented")
+}
+func (UnimplementedManagerServer) MoveFolder(context.Context, *MoveFolderRequest) (*MoveFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFolder not implemented")
+}
+func (UnimplementedManagerServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolder not implemented")
+}
+func (UnimplementedManagerServer) CreateNoteItem(context.Context, *CreateNoteItemRequest) (*CreateNoteItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNoteItem not implemented")
+}
+func (UnimplementedManagerServer) GetNoteItem(context.Context, *GetNoteItemRequest) (*GetNoteItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNoteItem not implemented")
+}
+func (UnimplementedManagerServer) GetNoteItems(context.Context, *GetNoteItemsRequest) (*GetNoteItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNoteItems not implemented")
+}
+func (UnimplementedManagerServer) UpdateNoteItem(context.Context, *UpdateNoteItemRequest) (*UpdateNoteItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNoteItem not implemented")
+}
+func (UnimplementedManagerServer) DeleteNoteItem(context.Context, *DeleteNoteItemRequest) (*DeleteNoteItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNoteItem not implemented")
+}
+func (UnimplementedManagerServer) CreateCardItem(context.Context, *CreateCardItemRequest) (*CreateCardItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCardItem not implemented")
+}
+func (UnimplementedManagerServer) GetCardItem(context.Context, *GetCardItemRequest) (*GetCardItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCardItem not implemented")
+}
+func (UnimplementedManagerServer) GetCardItems(context.Context, *GetCardItemsRequest) (*GetCardItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCardItems not implemented")
+}
+func (UnimplementedManagerServer) UpdateCardItem(context.Context, *UpdateCardItemRequest) (*UpdateCardItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCardItem not implemented")
+}
+func (UnimplementedManagerServer) DeleteCardItem(context.Context, *DeleteCardItemRequest) (*DeleteCardItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCardItem not implemented")
+}
+func (UnimplementedManagerServer) CreateIdentityItem(context.Context, *CreateIdentityItemRequest) (*CreateIdentityItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIdentityItem not implemented")
+}
+func (UnimplementedManagerServer) GetIdentityItem(context.Context, *GetIdentityItemRequest) (*GetIdentityItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityItem not implemented")
+}
+func (UnimplementedManagerServer) GetIdentityItems(context.Context, *GetIdentityItemsRequest) (*GetIdentityItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityItems not implemented")
+}
+func (UnimplementedManagerServer) UpdateIdentityItem(context.Context, *UpdateIdentityItemRequest) (*UpdateIdentityItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIdentityItem not implemented")
+}
+func (UnimplementedManagerServer) DeleteIdentityItem(context.Context, *DeleteIdentityItemRequest) (*DeleteIdentityItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIdentityItem not implemented")
+}
+func (UnimplementedManagerServer) CreateSSHKeyItem(context.Context, *CreateSSHKeyItemRequest) (*CreateSSHKeyItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSSHKeyItem not implemented")
+}
+func (UnimplementedManagerServer) GetSSHKeyItem(context.Context, *GetSSHKeyItemRequest) (*GetSSHKeyItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSSHKeyItem not implemented")
+}
+func (UnimplementedManagerServer) GetSSHKeyItems(context.Context, *GetSSHKeyItemsRequest) (*GetSSHKeyItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSSHKeyItems not implemented")
+}
+func (UnimplementedManagerServer) UpdateSSHKeyItem(context.Context, *UpdateSSHKeyItemRequest) (*UpdateSSHKeyItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSSHKeyItem not implemented")
+}
+func (UnimplementedManagerServer) DeleteSSHKeyItem(context.Context, *DeleteSSHKeyItemRequest) (*DeleteSSHKeyItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSSHKeyItem not implemented")
+}
+func (UnimplementedManagerServer) CreateAPIKeyItem(context.Context, *CreateAPIKeyItemRequest) (*CreateAPIKeyItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKeyItem not implemented")
+}
+func (UnimplementedManagerServer) GetAPIKeyItem(context.Context, *GetAPIKeyItemRequest) (*GetAPIKeyItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPIKeyItem not implemented")
+}
+func (UnimplementedManagerServer) GetAPIKeyItems(context.Context, *GetAPIKeyItemsRequest) (*GetAPIKeyItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPIKeyItems not implemented")
+}
+func (UnimplementedManagerServer) UpdateAPIKeyItem(context.Context, *UpdateAPIKeyItemRequest) (*UpdateAPIKeyItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAPIKeyItem not implemented")
+}
+func (UnimplementedManagerServer) DeleteAPIKeyItem(context.Context, *DeleteAPIKeyItemRequest) (*DeleteAPIKeyItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAPIKeyItem not implemented")
+}
+func (UnimplementedManagerServer) GeneratePassword(context.Context, *GeneratePasswordRequest) (*GeneratePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePassword not implemented")
+}
+func (UnimplementedManagerServer) GetVaultHealth(context.Context, *GetVaultHealthRequest) (*GetVaultHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVaultHealth not implemented")
+}
+func (UnimplementedManagerServer) CheckBreaches(context.Context, *CheckBreachesRequest) (*CheckBreachesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBreaches not implemented")
+}
+func (UnimplementedManagerServer) GetTOTPCode(context.Context, *GetTOTPCodeRequest) (*GetTOTPCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTOTPCode not implemented")
+}
+func (UnimplementedManagerServer) mustEmbedUnimplementedManagerServer() {}
+
+// UnsafeManagerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ManagerServer will
+// result in compilation errors.
+type UnsafeManagerServer interface {
+	mustEmbedUnimplementedManagerServer()
+}
+
+func RegisterManagerServer(s grpc.ServiceRegistrar, srv ManagerServer) {
+	s.RegisterService(&Manager_ServiceDesc, srv)
+}
+
+func _Manager_CreateLoginItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLoginItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).CreateLoginItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/CreateLoginItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).CreateLoginItem(ctx, req.(*CreateLoginItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetItem(ctx, req.(*GetItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetItems(ctx, req.(*GetItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetLoginItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetLoginItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetLoginItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetLoginItem(ctx, req.(*GetLoginItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetLoginItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetLoginItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetLoginItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetLoginItems(ctx, req.(*GetLoginItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetItemsByFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemsByFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetItemsByFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetItemsByFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetItemsByFolder(ctx, req.(*GetItemsByFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_DeleteLoginItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLoginItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).DeleteLoginItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/DeleteLoginItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).DeleteLoginItem(ctx, req.(*DeleteLoginItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).UpdateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/UpdateItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).UpdateItem(ctx, req.(*UpdateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/ListTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_RestoreItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).RestoreItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/RestoreItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).RestoreItem(ctx, req.(*RestoreItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_PurgeItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).PurgeItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/PurgeItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).PurgeItem(ctx, req.(*PurgeItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_UpdateLoginItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLoginItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).UpdateLoginItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/UpdateLoginItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).UpdateLoginItem(ctx, req.(*UpdateLoginItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetLoginItemHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginItemHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetLoginItemHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetLoginItemHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetLoginItemHistory(ctx, req.(*GetLoginItemHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_RestoreLoginItemPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreLoginItemPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).RestoreLoginItemPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/RestoreLoginItemPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).RestoreLoginItemPassword(ctx, req.(*RestoreLoginItemPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/CreateFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).CreateFolder(ctx, req.(*CreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetFolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetFolders(ctx, req.(*GetFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetFolderByPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFolderByPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetFolderByPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetFolderByPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetFolderByPath(ctx, req.(*GetFolderByPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_RenameFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).RenameFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/RenameFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).RenameFolder(ctx, req.(*RenameFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_MoveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).MoveFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/MoveFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).MoveFolder(ctx, req.(*MoveFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).DeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/DeleteFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).DeleteFolder(ctx, req.(*DeleteFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_CreateNoteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNoteItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).CreateNoteItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/CreateNoteItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).CreateNoteItem(ctx, req.(*CreateNoteItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetNoteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoteItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetNoteItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetNoteItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetNoteItem(ctx, req.(*GetNoteItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetNoteItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoteItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetNoteItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetNoteItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetNoteItems(ctx, req.(*GetNoteItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_UpdateNoteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNoteItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).UpdateNoteItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/UpdateNoteItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).UpdateNoteItem(ctx, req.(*UpdateNoteItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_DeleteNoteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNoteItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).DeleteNoteItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/DeleteNoteItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).DeleteNoteItem(ctx, req.(*DeleteNoteItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_CreateCardItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCardItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).CreateCardItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/CreateCardItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).CreateCardItem(ctx, req.(*CreateCardItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetCardItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCardItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetCardItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetCardItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetCardItem(ctx, req.(*GetCardItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetCardItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCardItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetCardItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetCardItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetCardItems(ctx, req.(*GetCardItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_UpdateCardItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCardItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).UpdateCardItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/UpdateCardItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).UpdateCardItem(ctx, req.(*UpdateCardItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_DeleteCardItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCardItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).DeleteCardItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/DeleteCardItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).DeleteCardItem(ctx, req.(*DeleteCardItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_CreateIdentityItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIdentityItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).CreateIdentityItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/CreateIdentityItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).CreateIdentityItem(ctx, req.(*CreateIdentityItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetIdentityItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetIdentityItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetIdentityItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetIdentityItem(ctx, req.(*GetIdentityItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetIdentityItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetIdentityItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetIdentityItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetIdentityItems(ctx, req.(*GetIdentityItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_UpdateIdentityItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIdentityItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).UpdateIdentityItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/UpdateIdentityItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).UpdateIdentityItem(ctx, req.(*UpdateIdentityItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_DeleteIdentityItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIdentityItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).DeleteIdentityItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/DeleteIdentityItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).DeleteIdentityItem(ctx, req.(*DeleteIdentityItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_CreateSSHKeyItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSSHKeyItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).CreateSSHKeyItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/CreateSSHKeyItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).CreateSSHKeyItem(ctx, req.(*CreateSSHKeyItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetSSHKeyItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSSHKeyItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetSSHKeyItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetSSHKeyItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetSSHKeyItem(ctx, req.(*GetSSHKeyItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetSSHKeyItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSSHKeyItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetSSHKeyItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetSSHKeyItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetSSHKeyItems(ctx, req.(*GetSSHKeyItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_UpdateSSHKeyItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSSHKeyItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).UpdateSSHKeyItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/UpdateSSHKeyItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).UpdateSSHKeyItem(ctx, req.(*UpdateSSHKeyItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_DeleteSSHKeyItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSSHKeyItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).DeleteSSHKeyItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/DeleteSSHKeyItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).DeleteSSHKeyItem(ctx, req.(*DeleteSSHKeyItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_CreateAPIKeyItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).CreateAPIKeyItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/CreateAPIKeyItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).CreateAPIKeyItem(ctx, req.(*CreateAPIKeyItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetAPIKeyItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPIKeyItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetAPIKeyItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetAPIKeyItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetAPIKeyItem(ctx, req.(*GetAPIKeyItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetAPIKeyItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPIKeyItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetAPIKeyItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetAPIKeyItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetAPIKeyItems(ctx, req.(*GetAPIKeyItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_UpdateAPIKeyItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAPIKeyItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).UpdateAPIKeyItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/UpdateAPIKeyItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).UpdateAPIKeyItem(ctx, req.(*UpdateAPIKeyItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_DeleteAPIKeyItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAPIKeyItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).DeleteAPIKeyItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/DeleteAPIKeyItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).DeleteAPIKeyItem(ctx, req.(*DeleteAPIKeyItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GeneratePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GeneratePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GeneratePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GeneratePassword(ctx, req.(*GeneratePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetVaultHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetVaultHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetVaultHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetVaultHealth(ctx, req.(*GetVaultHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_CheckBreaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBreachesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).CheckBreaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/CheckBreaches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).CheckBreaches(ctx, req.(*CheckBreachesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetTOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTOTPCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetTOTPCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manager.Manager/GetTOTPCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetTOTPCode(ctx, req.(*GetTOTPCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Manager_ServiceDesc is the grpc.ServiceDesc for Manager service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Manager_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "manager.Manager",
+	HandlerType: (*ManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLoginItem",
+			Handler:    _Manager_CreateLoginItem_Handler,
+		},
+		{
+			MethodName: "GetItem",
+			Handler:    _Manager_GetItem_Handler,
+		},
+		{
+			MethodName: "GetItems",
+			Handler:    _Manager_GetItems_Handler,
+		},
+		{
+			MethodName: "GetLoginItem",
+			Handler:    _Manager_GetLoginItem_Handler,
+		},
+		{
+			MethodName: "GetLoginItems",
+			Handler:    _Manager_GetLoginItems_Handler,
+		},
+		{
+			MethodName: "GetItemsByFolder",
+			Handler:    _Manager_GetItemsByFolder_Handler,
+		},
+		{
+			MethodName: "DeleteLoginItem",
+			Handler:    _Manager_DeleteLoginItem_Handler,
+		},
+		{
+			MethodName: "UpdateItem",
+			Handler:    _Manager_UpdateItem_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _Manager_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreItem",
+			Handler:    _Manager_RestoreItem_Handler,
+		},
+		{
+			MethodName: "PurgeItem",
+			Handler:    _Manager_PurgeItem_Handler,
+		},
+		{
+			MethodName: "UpdateLoginItem",
+			Handler:    _Manager_UpdateLoginItem_Handler,
+		},
+		{
+			MethodName: "GetLoginItemHistory",
+			Handler:    _Manager_GetLoginItemHistory_Handler,
+		},
+		{
+			MethodName: "RestoreLoginItemPassword",
+			Handler:    _Manager_RestoreLoginItemPassword_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _Manager_CreateFolder_Handler,
+		},
+		{
+			MethodName: "GetFolders",
+			Handler:    _Manager_GetFolders_Handler,
+		},
+		{
+			MethodName: "GetFolderByPath",
+			Handler:    _Manager_GetFolderByPath_Handler,
+		},
+		{
+			MethodName: "RenameFolder",
+			Handler:    _Manager_RenameFolder_Handler,
+		},
+		{
+			MethodName: "MoveFolder",
+			Handler:    _Manager_MoveFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _Manager_DeleteFolder_Handler,
+		},
+		{
+			MethodName: "CreateNoteItem",
+			Handler:    _Manager_CreateNoteItem_Handler,
+		},
+		{
+			MethodName: "GetNoteItem",
+			Handler:    _Manager_GetNoteItem_Handler,
+		},
+		{
+			MethodName: "GetNoteItems",
+			Handler:    _Manager_GetNoteItems_Handler,
+		},
+		{
+			MethodName: "UpdateNoteItem",
+			Handler:    _Manager_UpdateNoteItem_Handler,
+		},
+		{
+			MethodName: "DeleteNoteItem",
+			Handler:    _Manager_DeleteNoteItem_Handler,
+		},
+		{
+			MethodName: "CreateCardItem",
+			Handler:    _Manager_CreateCardItem_Handler,
+		},
+		{
+			MethodName: "GetCardItem",
+			Handler:    _Manager_GetCardItem_Handler,
+		},
+		{
+			MethodName: "GetCardItems",
+			Handler:    _Manager_GetCardItems_Handler,
+		},
+		{
+			MethodName: "UpdateCardItem",
+			Handler:    _Manager_UpdateCardItem_Handler,
+		},
+		{
+			MethodName: "DeleteCardItem",
+			Handler:    _Manager_DeleteCardItem_Handler,
+		},
+		{
+			MethodName: "CreateIdentityItem",
+			Handler:    _Manager_CreateIdentityItem_Handler,
+		},
+		{
+			MethodName: "GetIdentityItem",
+			Handler:    _Manager_GetIdentityItem_Handler,
+		},
+		{
+			MethodName: "GetIdentityItems",
+			Handler:    _Manager_GetIdentityItems_Handler,
+		},
+		{
+			MethodName: "UpdateIdentityItem",
+			Handler:    _Manager_UpdateIdentityItem_Handler,
+		},
+		{
+			MethodName: "DeleteIdentityItem",
+			Handler:    _Manager_DeleteIdentityItem_Handler,
+		},
+		{
+			MethodName: "CreateSSHKeyItem",
+			Handler:    _Manager_CreateSSHKeyItem_Handler,
+		},
+		{
+			MethodName: "GetSSHKeyItem",
+			Handler:    _Manager_GetSSHKeyItem_Handler,
+		},
+		{
+			MethodName: "GetSSHKeyItems",
+			Handler:    _Manager_GetSSHKeyItems_Handler,
+		},
+		{
+			MethodName: "UpdateSSHKeyItem",
+			Handler:    _Manager_UpdateSSHKeyItem_Handler,
+		},
+		{
+			MethodName: "DeleteSSHKeyItem",
+			Handler:    _Manager_DeleteSSHKeyItem_Handler,
+		},
+		{
+			MethodName: "CreateAPIKeyItem",
+			Handler:    _Manager_CreateAPIKeyItem_Handler,
+		},
+		{
+			MethodName: "GetAPIKeyItem",
+			Handler:    _Manager_GetAPIKeyItem_Handler,
+		},
+		{
+			MethodName: "GetAPIKeyItems",
+			Handler:    _Manager_GetAPIKeyItems_Handler,
+		},
+		{
+			MethodName: "UpdateAPIKeyItem",
+			Handler:    _Manager_UpdateAPIKeyItem_Handler,
+		},
+		{
+			MethodName: "DeleteAPIKeyItem",
+			Handler:    _Manager_DeleteAPIKeyItem_Handler,
+		},
+		{
+			MethodName: "GeneratePassword",
+			Handler:    _Manager_GeneratePassword_Handler,
+		},
+		{
+			MethodName: "GetVaultHealth",
+			Handler:    _Manager_GetVaultHealth_Handler,
+		},
+		{
+			MethodName: "CheckBreaches",
+			Handler:    _Manager_CheckBreaches_Handler,
+		},
+		{
+			MethodName: "GetTOTPCode",
+			Handler:    _Manager_GetTOTPCode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "manager/manager.proto",
+}